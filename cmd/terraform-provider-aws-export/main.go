@@ -0,0 +1,115 @@
+// Command terraform-provider-aws-export writes Terraform configuration and import IDs
+// for existing resources of the given types in an AWS region.
+//
+// Usage:
+//
+//	terraform-provider-aws-export -region us-west-2 -types aws_sqs_queue,aws_iam_role -out ./adopted
+//
+// The provider is configured from the environment in the same way as by Terraform,
+// e.g. AWS_PROFILE or AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY.
+// Resource types that cannot be listed and resources that cannot be imported or read,
+// e.g. for lack of permissions, are logged to standard error and skipped.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/nij4t/terraform-provider-aws/internal/export"
+	"github.com/nij4t/terraform-provider-aws/internal/provider"
+)
+
+const (
+	importsBlocks   = "blocks"
+	importsCommands = "commands"
+)
+
+func main() {
+	var (
+		imports string
+		out     string
+		profile string
+		region  string
+		types   string
+	)
+
+	flag.StringVar(&imports, "imports", importsBlocks, fmt.Sprintf("how to write import IDs: %q for import blocks in imports.tf, %q for terraform import commands in import.sh", importsBlocks, importsCommands))
+	flag.StringVar(&out, "out", ".", "directory to write configuration to")
+	flag.StringVar(&profile, "profile", "", "AWS profile to use")
+	flag.StringVar(&region, "region", "", "AWS region to export resources from")
+	flag.StringVar(&types, "types", strings.Join(export.SupportedTypes(), ","), "comma-separated list of resource types to export")
+	flag.Parse()
+
+	if imports != importsBlocks && imports != importsCommands {
+		log.Fatalf("invalid -imports value: %s", imports)
+	}
+
+	ctx := context.Background()
+	p := provider.Provider()
+
+	raw := map[string]interface{}{}
+
+	if profile != "" {
+		raw["profile"] = profile
+	}
+
+	if region != "" {
+		raw["region"] = region
+	}
+
+	if diags := p.Configure(ctx, terraform.NewResourceConfigRaw(raw)); diags.HasError() {
+		log.Fatalf("error configuring provider: %v", diags)
+	}
+
+	resources, err := export.New(p).Export(ctx, strings.Split(types, ","))
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, r := range resources {
+		if len(r.Drift) > 0 {
+			log.Printf("[WARN] %s will not plan clean, check: %s", r.Address(), strings.Join(r.Drift, ", "))
+		}
+	}
+
+	if err := writeFile(filepath.Join(out, "main.tf"), resources, export.WriteResources); err != nil {
+		log.Fatal(err)
+	}
+
+	switch imports {
+	case importsBlocks:
+		err = writeFile(filepath.Join(out, "imports.tf"), resources, export.WriteImportBlocks)
+	case importsCommands:
+		err = writeFile(filepath.Join(out, "import.sh"), resources, export.WriteImportCommands)
+	}
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	log.Printf("[INFO] exported %d resources to %s", len(resources), out)
+}
+
+func writeFile(path string, resources []*export.Resource, write func(io.Writer, []*export.Resource) error) error {
+	f, err := os.Create(path)
+
+	if err != nil {
+		return err
+	}
+
+	if err := write(f, resources); err != nil {
+		f.Close()
+
+		return fmt.Errorf("error writing %s: %w", path, err)
+	}
+
+	return f.Close()
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/organizations"
 	"github.com/aws/aws-sdk-go/service/outposts"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -80,6 +81,14 @@ var Providers map[string]*schema.Provider
 // for tests requiring special provider configurations.
 var ProviderFactories map[string]func() (*schema.Provider, error)
 
+// ProtoV5ProviderFactories is a static map containing only the main provider instance
+// served by the mux server, which combines the Plugin SDK provider with the
+// terraform-plugin-framework provider.
+//
+// Use this instead of ProviderFactories for tests of resources and data sources
+// implemented with terraform-plugin-framework.
+var ProtoV5ProviderFactories map[string]func() (tfprotov5.ProviderServer, error)
+
 // Provider is the "main" provider instance
 //
 // This Provider can be used in testing code for API calls without requiring
@@ -108,6 +117,27 @@ func init() {
 	ProviderFactories = map[string]func() (*schema.Provider, error){
 		ProviderName: func() (*schema.Provider, error) { return provider.Provider(), nil }, //nolint:unparam
 	}
+
+	ProtoV5ProviderFactories = protoV5ProviderFactoriesInit(ProviderName)
+}
+
+// protoV5ProviderFactoriesInit creates ProtoV5ProviderFactories for the provider under testing.
+func protoV5ProviderFactoriesInit(providerNames ...string) map[string]func() (tfprotov5.ProviderServer, error) {
+	var factories = make(map[string]func() (tfprotov5.ProviderServer, error), len(providerNames))
+
+	for _, name := range providerNames {
+		factories[name] = func() (tfprotov5.ProviderServer, error) {
+			providerServerFactory, _, err := provider.ProtoV5ProviderServerFactory(context.Background())
+
+			if err != nil {
+				return nil, err
+			}
+
+			return providerServerFactory(), nil
+		}
+	}
+
+	return factories
 }
 
 // factoriesInit creates ProviderFactories for the provider under testing.
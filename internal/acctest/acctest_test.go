@@ -1,12 +1,15 @@
 package acctest
 
 import (
+	"context"
 	"fmt"
 	"reflect"
+	"regexp"
 	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -25,6 +28,33 @@ func TestProvider_impl(t *testing.T) {
 	var _ *schema.Provider = provider.Provider()
 }
 
+func TestProvider_protoV5ProviderServer(t *testing.T) {
+	server, err := ProtoV5ProviderFactories[ProviderName]()
+
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// The mux server returns an error diagnostic if the muxed providers' schemas differ.
+	output, err := server.GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	for _, d := range output.Diagnostics {
+		t.Errorf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+
+	if _, ok := output.ResourceSchemas["aws_vpc"]; !ok {
+		t.Errorf("expected resource schema for aws_vpc")
+	}
+
+	if _, ok := output.DataSourceSchemas["aws_partition"]; !ok {
+		t.Errorf("expected data source schema for aws_partition")
+	}
+}
+
 func TestReverseDNS(t *testing.T) {
 	testCases := []struct {
 		name     string
@@ -168,6 +198,62 @@ func TestAccAcctestProvider_DefaultAndIgnoreTags_emptyBlocks(t *testing.T) {
 	})
 }
 
+func TestAccAcctestProvider_protoV5ProviderFactories(t *testing.T) {
+	dataSourceName := "data.aws_partition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { PreCheck(t) },
+		ErrorCheck:               ErrorCheck(t),
+		ProtoV5ProviderFactories: ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "aws_partition" "test" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "partition", Partition()),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAcctestProvider_regionOverride(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(ResourcePrefix)
+	resourceName := "aws_sqs_queue.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			PreCheck(t)
+			PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:        ErrorCheck(t),
+		ProviderFactories: ProviderFactories,
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRegionOverrideConfig(rName, AlternateRegion()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "region", AlternateRegion()),
+					resource.TestMatchResourceAttr(resourceName, "url", regexp.MustCompile(fmt.Sprintf(`\.%s\.`, AlternateRegion()))),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccRegionalImportStateIdFunc(resourceName, AlternateRegion()),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRegionOverrideConfig(rName, Region()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "region", Region()),
+					resource.TestMatchResourceAttr(resourceName, "url", regexp.MustCompile(fmt.Sprintf(`\.%s\.`, Region()))),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAcctestProvider_endpoints(t *testing.T) {
 	var providers []*schema.Provider
 	var endpoints strings.Builder
@@ -875,6 +961,27 @@ func testAccCheckUnusualEndpoints(providers *[]*schema.Provider, unusual1, unusu
 	}
 }
 
+func testAccRegionalImportStateIdFunc(resourceName, region string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return rs.Primary.ID + "@" + region, nil
+	}
+}
+
+func testAccRegionOverrideConfig(rName, region string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  name   = %[1]q
+  region = %[2]q
+}
+`, rName, region)
+}
+
 func testAccEndpointsConfig(endpoints string) string {
 	//lintignore:AT004
 	return ConfigCompose(
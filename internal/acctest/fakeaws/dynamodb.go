@@ -0,0 +1,543 @@
+package fakeaws
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+const dynamoDBTargetPrefix = "DynamoDB_20120810."
+
+// dynamoDBError is an AWS JSON protocol error.
+type dynamoDBError struct {
+	statusCode int
+	code       string
+	message    string
+}
+
+func (e *dynamoDBError) Error() string {
+	return fmt.Sprintf("%s: %s", e.code, e.message)
+}
+
+func dynamoDBValidationError(format string, a ...interface{}) error {
+	return &dynamoDBError{http.StatusBadRequest, "ValidationException", fmt.Sprintf(format, a...)}
+}
+
+// dynamoDBObject is a JSON object in a DynamoDB request or response.
+type dynamoDBObject = map[string]interface{}
+
+type dynamoDBTable struct {
+	// description is the table's TableDescription.
+	description dynamoDBObject
+
+	pointInTimeRecoveryStatus string
+	tags                      map[string]string
+	timeToLive                dynamoDBObject
+}
+
+type dynamoDBService struct {
+	tables map[string]*dynamoDBTable
+}
+
+func newDynamoDBService(server *Server) *dynamoDBService {
+	return &dynamoDBService{
+		tables: map[string]*dynamoDBTable{},
+	}
+}
+
+func (svc *dynamoDBService) handle(w http.ResponseWriter, r *http.Request, region string) {
+	actions := map[string]func(input dynamoDBObject, region string) (interface{}, error){
+		"CreateTable":               svc.createTable,
+		"DeleteTable":               svc.deleteTable,
+		"DescribeContinuousBackups": svc.describeContinuousBackups,
+		"DescribeTable":             svc.describeTable,
+		"DescribeTimeToLive":        svc.describeTimeToLive,
+		"ListTables":                svc.listTables,
+		"ListTagsOfResource":        svc.listTagsOfResource,
+		"TagResource":               svc.tagResource,
+		"UntagResource":             svc.untagResource,
+		"UpdateContinuousBackups":   svc.updateContinuousBackups,
+		"UpdateTable":               svc.updateTable,
+		"UpdateTimeToLive":          svc.updateTimeToLive,
+	}
+
+	name := strings.TrimPrefix(r.Header.Get("X-Amz-Target"), dynamoDBTargetPrefix)
+	action, ok := actions[name]
+
+	if !ok {
+		writeDynamoDBError(w, &dynamoDBError{http.StatusBadRequest, "UnknownOperationException", fmt.Sprintf("Operation %s is not implemented", name)})
+		return
+	}
+
+	var input dynamoDBObject
+
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		writeDynamoDBError(w, &dynamoDBError{http.StatusBadRequest, "SerializationException", err.Error()})
+		return
+	}
+
+	output, err := action(input, region)
+
+	if err != nil {
+		dErr, ok := err.(*dynamoDBError)
+
+		if !ok {
+			dErr = &dynamoDBError{http.StatusInternalServerError, "InternalServerError", err.Error()}
+		}
+
+		writeDynamoDBError(w, dErr)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, output)
+}
+
+func writeDynamoDBError(w http.ResponseWriter, err *dynamoDBError) {
+	writeJSON(w, err.statusCode, map[string]string{
+		"__type":  "com.amazonaws.dynamodb.v20120810#" + err.code,
+		"message": err.message,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, v interface{}) {
+	b, err := json.Marshal(v)
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-amz-json-1.0")
+	w.WriteHeader(statusCode)
+	w.Write(b) //nolint:errcheck
+}
+
+func dynamoDBString(input dynamoDBObject, key string) string {
+	v, _ := input[key].(string)
+
+	return v
+}
+
+func dynamoDBObjects(input dynamoDBObject, key string) []dynamoDBObject {
+	var objects []dynamoDBObject
+
+	if v, ok := input[key].([]interface{}); ok {
+		for _, e := range v {
+			if o, ok := e.(dynamoDBObject); ok {
+				objects = append(objects, o)
+			}
+		}
+	}
+
+	return objects
+}
+
+func dynamoDBTags(input dynamoDBObject) map[string]string {
+	tags := map[string]string{}
+
+	for _, v := range dynamoDBObjects(input, "Tags") {
+		tags[dynamoDBString(v, "Key")] = dynamoDBString(v, "Value")
+	}
+
+	return tags
+}
+
+func (svc *dynamoDBService) findTable(name string) (*dynamoDBTable, error) {
+	table, ok := svc.tables[name]
+
+	if !ok {
+		return nil, &dynamoDBError{http.StatusBadRequest, "ResourceNotFoundException", fmt.Sprintf("Requested resource not found: Table: %s not found", name)}
+	}
+
+	return table, nil
+}
+
+func (svc *dynamoDBService) findTableByARN(tableARN string) (*dynamoDBTable, error) {
+	for _, table := range svc.tables {
+		if table.description["TableArn"] == tableARN {
+			return table, nil
+		}
+	}
+
+	return nil, &dynamoDBError{http.StatusBadRequest, "ResourceNotFoundException", fmt.Sprintf("Requested resource not found: ResourcArn: %s not found", tableARN)}
+}
+
+// dynamoDBProvisionedThroughput returns a ProvisionedThroughputDescription.
+func dynamoDBProvisionedThroughput(billingMode string, v interface{}) dynamoDBObject {
+	description := dynamoDBObject{
+		"NumberOfDecreasesToday": 0,
+		"ReadCapacityUnits":      0,
+		"WriteCapacityUnits":     0,
+	}
+
+	if input, ok := v.(dynamoDBObject); ok && billingMode != "PAY_PER_REQUEST" {
+		description["ReadCapacityUnits"] = input["ReadCapacityUnits"]
+		description["WriteCapacityUnits"] = input["WriteCapacityUnits"]
+	}
+
+	return description
+}
+
+func dynamoDBGlobalSecondaryIndex(tableARN, billingMode string, input dynamoDBObject) dynamoDBObject {
+	name := dynamoDBString(input, "IndexName")
+
+	return dynamoDBObject{
+		"IndexArn":              tableARN + "/index/" + name,
+		"IndexName":             name,
+		"IndexSizeBytes":        0,
+		"IndexStatus":           "ACTIVE",
+		"ItemCount":             0,
+		"KeySchema":             input["KeySchema"],
+		"Projection":            input["Projection"],
+		"ProvisionedThroughput": dynamoDBProvisionedThroughput(billingMode, input["ProvisionedThroughput"]),
+	}
+}
+
+func (svc *dynamoDBService) setBillingMode(description dynamoDBObject, billingMode string, provisionedThroughput interface{}) {
+	description["BillingModeSummary"] = dynamoDBObject{
+		"BillingMode":                       billingMode,
+		"LastUpdateToPayPerRequestDateTime": float64(time.Now().Unix()),
+	}
+	description["ProvisionedThroughput"] = dynamoDBProvisionedThroughput(billingMode, provisionedThroughput)
+}
+
+func (svc *dynamoDBService) setStreamSpecification(description dynamoDBObject, v interface{}) {
+	specification, ok := v.(dynamoDBObject)
+
+	if !ok {
+		return
+	}
+
+	if enabled, _ := specification["StreamEnabled"].(bool); !enabled {
+		delete(description, "StreamSpecification")
+		return
+	}
+
+	label := time.Now().UTC().Format("2006-01-02T15:04:05.000")
+	description["StreamSpecification"] = specification
+	description["LatestStreamArn"] = fmt.Sprintf("%s/stream/%s", description["TableArn"], label)
+	description["LatestStreamLabel"] = label
+}
+
+func (svc *dynamoDBService) setSSESpecification(description dynamoDBObject, v interface{}, region string) {
+	specification, ok := v.(dynamoDBObject)
+
+	if !ok {
+		return
+	}
+
+	if enabled, _ := specification["Enabled"].(bool); !enabled {
+		delete(description, "SSEDescription")
+		return
+	}
+
+	keyARN := dynamoDBString(specification, "KMSMasterKeyId")
+
+	if keyARN == "" {
+		keyARN = arn("kms", region, "key/00000000-0000-0000-0000-000000000000")
+	}
+
+	description["SSEDescription"] = dynamoDBObject{
+		"KMSMasterKeyArn": keyARN,
+		"SSEType":         "KMS",
+		"Status":          "ENABLED",
+	}
+}
+
+func (svc *dynamoDBService) createTable(input dynamoDBObject, region string) (interface{}, error) {
+	name := dynamoDBString(input, "TableName")
+
+	if _, ok := svc.tables[name]; ok {
+		return nil, &dynamoDBError{http.StatusBadRequest, "ResourceInUseException", fmt.Sprintf("Table already exists: %s", name)}
+	}
+
+	if len(dynamoDBObjects(input, "KeySchema")) == 0 {
+		return nil, dynamoDBValidationError("1 validation error detected: Value null at 'keySchema' failed to satisfy constraint: Member must not be null")
+	}
+
+	billingMode := dynamoDBString(input, "BillingMode")
+
+	if billingMode == "" {
+		billingMode = "PROVISIONED"
+	}
+
+	if _, ok := input["ProvisionedThroughput"]; !ok && billingMode == "PROVISIONED" {
+		return nil, dynamoDBValidationError("One or more parameter values were invalid: ReadCapacityUnits and WriteCapacityUnits must both be specified when BillingMode is PROVISIONED")
+	}
+
+	tableARN := arn("dynamodb", region, "table/"+name)
+	description := dynamoDBObject{
+		"AttributeDefinitions": input["AttributeDefinitions"],
+		"CreationDateTime":     float64(time.Now().Unix()),
+		"ItemCount":            0,
+		"KeySchema":            input["KeySchema"],
+		"TableArn":             tableARN,
+		"TableId":              fmt.Sprintf("00000000-0000-0000-0000-%012d", len(svc.tables)+1),
+		"TableName":            name,
+		"TableSizeBytes":       0,
+		"TableStatus":          "ACTIVE",
+	}
+
+	svc.setBillingMode(description, billingMode, input["ProvisionedThroughput"])
+	svc.setStreamSpecification(description, input["StreamSpecification"])
+	svc.setSSESpecification(description, input["SSESpecification"], region)
+
+	var globalSecondaryIndexes []dynamoDBObject
+
+	for _, v := range dynamoDBObjects(input, "GlobalSecondaryIndexes") {
+		globalSecondaryIndexes = append(globalSecondaryIndexes, dynamoDBGlobalSecondaryIndex(tableARN, billingMode, v))
+	}
+
+	if len(globalSecondaryIndexes) > 0 {
+		description["GlobalSecondaryIndexes"] = globalSecondaryIndexes
+	}
+
+	var localSecondaryIndexes []dynamoDBObject
+
+	for _, v := range dynamoDBObjects(input, "LocalSecondaryIndexes") {
+		localSecondaryIndexes = append(localSecondaryIndexes, dynamoDBObject{
+			"IndexArn":       tableARN + "/index/" + dynamoDBString(v, "IndexName"),
+			"IndexName":      v["IndexName"],
+			"IndexSizeBytes": 0,
+			"ItemCount":      0,
+			"KeySchema":      v["KeySchema"],
+			"Projection":     v["Projection"],
+		})
+	}
+
+	if len(localSecondaryIndexes) > 0 {
+		description["LocalSecondaryIndexes"] = localSecondaryIndexes
+	}
+
+	svc.tables[name] = &dynamoDBTable{
+		description:               description,
+		pointInTimeRecoveryStatus: "DISABLED",
+		tags:                      dynamoDBTags(input),
+		timeToLive:                dynamoDBObject{"TimeToLiveStatus": "DISABLED"},
+	}
+
+	return dynamoDBObject{"TableDescription": description}, nil
+}
+
+func (svc *dynamoDBService) describeTable(input dynamoDBObject, region string) (interface{}, error) {
+	table, err := svc.findTable(dynamoDBString(input, "TableName"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return dynamoDBObject{"Table": table.description}, nil
+}
+
+func (svc *dynamoDBService) updateTable(input dynamoDBObject, region string) (interface{}, error) {
+	table, err := svc.findTable(dynamoDBString(input, "TableName"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := input["ReplicaUpdates"]; ok {
+		return nil, dynamoDBValidationError("Replicas are not implemented")
+	}
+
+	description := table.description
+	billingMode := dynamoDBString(description["BillingModeSummary"].(dynamoDBObject), "BillingMode")
+
+	if v := dynamoDBString(input, "BillingMode"); v != "" {
+		billingMode = v
+	}
+
+	if _, ok := input["BillingMode"]; ok {
+		svc.setBillingMode(description, billingMode, input["ProvisionedThroughput"])
+	} else if v, ok := input["ProvisionedThroughput"]; ok {
+		description["ProvisionedThroughput"] = dynamoDBProvisionedThroughput(billingMode, v)
+	}
+
+	if v, ok := input["AttributeDefinitions"]; ok {
+		description["AttributeDefinitions"] = v
+	}
+
+	globalSecondaryIndexes, _ := description["GlobalSecondaryIndexes"].([]dynamoDBObject)
+
+	for _, update := range dynamoDBObjects(input, "GlobalSecondaryIndexUpdates") {
+		switch {
+		case update["Create"] != nil:
+			globalSecondaryIndexes = append(globalSecondaryIndexes, dynamoDBGlobalSecondaryIndex(dynamoDBString(description, "TableArn"), billingMode, update["Create"].(dynamoDBObject)))
+		case update["Update"] != nil:
+			v := update["Update"].(dynamoDBObject)
+
+			for _, index := range globalSecondaryIndexes {
+				if index["IndexName"] == v["IndexName"] {
+					index["ProvisionedThroughput"] = dynamoDBProvisionedThroughput(billingMode, v["ProvisionedThroughput"])
+				}
+			}
+		case update["Delete"] != nil:
+			v := update["Delete"].(dynamoDBObject)
+
+			for i, index := range globalSecondaryIndexes {
+				if index["IndexName"] == v["IndexName"] {
+					globalSecondaryIndexes = append(globalSecondaryIndexes[:i], globalSecondaryIndexes[i+1:]...)
+					break
+				}
+			}
+		}
+	}
+
+	if len(globalSecondaryIndexes) > 0 {
+		description["GlobalSecondaryIndexes"] = globalSecondaryIndexes
+	} else {
+		delete(description, "GlobalSecondaryIndexes")
+	}
+
+	svc.setStreamSpecification(description, input["StreamSpecification"])
+	svc.setSSESpecification(description, input["SSESpecification"], region)
+
+	return dynamoDBObject{"TableDescription": description}, nil
+}
+
+func (svc *dynamoDBService) deleteTable(input dynamoDBObject, region string) (interface{}, error) {
+	table, err := svc.findTable(dynamoDBString(input, "TableName"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	delete(svc.tables, dynamoDBString(table.description, "TableName"))
+
+	description := dynamoDBObject{}
+
+	for k, v := range table.description {
+		description[k] = v
+	}
+
+	description["TableStatus"] = "DELETING"
+
+	return dynamoDBObject{"TableDescription": description}, nil
+}
+
+func (svc *dynamoDBService) listTables(input dynamoDBObject, region string) (interface{}, error) {
+	names := []string{}
+
+	for name := range svc.tables {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return dynamoDBObject{"TableNames": names}, nil
+}
+
+func (svc *dynamoDBService) describeContinuousBackups(input dynamoDBObject, region string) (interface{}, error) {
+	table, err := svc.findTable(dynamoDBString(input, "TableName"))
+
+	if err != nil {
+		return nil, &dynamoDBError{http.StatusBadRequest, "TableNotFoundException", err.(*dynamoDBError).message}
+	}
+
+	return dynamoDBObject{
+		"ContinuousBackupsDescription": dynamoDBObject{
+			"ContinuousBackupsStatus": "ENABLED",
+			"PointInTimeRecoveryDescription": dynamoDBObject{
+				"PointInTimeRecoveryStatus": table.pointInTimeRecoveryStatus,
+			},
+		},
+	}, nil
+}
+
+func (svc *dynamoDBService) updateContinuousBackups(input dynamoDBObject, region string) (interface{}, error) {
+	table, err := svc.findTable(dynamoDBString(input, "TableName"))
+
+	if err != nil {
+		return nil, &dynamoDBError{http.StatusBadRequest, "TableNotFoundException", err.(*dynamoDBError).message}
+	}
+
+	table.pointInTimeRecoveryStatus = "DISABLED"
+
+	if specification, ok := input["PointInTimeRecoverySpecification"].(dynamoDBObject); ok {
+		if enabled, _ := specification["PointInTimeRecoveryEnabled"].(bool); enabled {
+			table.pointInTimeRecoveryStatus = "ENABLED"
+		}
+	}
+
+	return svc.describeContinuousBackups(input, region)
+}
+
+func (svc *dynamoDBService) describeTimeToLive(input dynamoDBObject, region string) (interface{}, error) {
+	table, err := svc.findTable(dynamoDBString(input, "TableName"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return dynamoDBObject{"TimeToLiveDescription": table.timeToLive}, nil
+}
+
+func (svc *dynamoDBService) updateTimeToLive(input dynamoDBObject, region string) (interface{}, error) {
+	table, err := svc.findTable(dynamoDBString(input, "TableName"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	specification, ok := input["TimeToLiveSpecification"].(dynamoDBObject)
+
+	if !ok {
+		return nil, dynamoDBValidationError("1 validation error detected: Value null at 'timeToLiveSpecification' failed to satisfy constraint: Member must not be null")
+	}
+
+	if enabled, _ := specification["Enabled"].(bool); enabled {
+		table.timeToLive = dynamoDBObject{
+			"AttributeName":    specification["AttributeName"],
+			"TimeToLiveStatus": "ENABLED",
+		}
+	} else {
+		table.timeToLive = dynamoDBObject{"TimeToLiveStatus": "DISABLED"}
+	}
+
+	return dynamoDBObject{"TimeToLiveSpecification": specification}, nil
+}
+
+func (svc *dynamoDBService) listTagsOfResource(input dynamoDBObject, region string) (interface{}, error) {
+	table, err := svc.findTableByARN(dynamoDBString(input, "ResourceArn"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return dynamoDBObject{"Tags": tagList(table.tags)}, nil
+}
+
+func (svc *dynamoDBService) tagResource(input dynamoDBObject, region string) (interface{}, error) {
+	table, err := svc.findTableByARN(dynamoDBString(input, "ResourceArn"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range dynamoDBTags(input) {
+		table.tags[k] = v
+	}
+
+	return dynamoDBObject{}, nil
+}
+
+func (svc *dynamoDBService) untagResource(input dynamoDBObject, region string) (interface{}, error) {
+	table, err := svc.findTableByARN(dynamoDBString(input, "ResourceArn"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if keys, ok := input["TagKeys"].([]interface{}); ok {
+		for _, k := range keys {
+			if k, ok := k.(string); ok {
+				delete(table.tags, k)
+			}
+		}
+	}
+
+	return dynamoDBObject{}, nil
+}
@@ -0,0 +1,839 @@
+package fakeaws
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const iamXMLNamespace = "https://iam.amazonaws.com/doc/2010-05-08/"
+
+// iamMaxPolicyVersions is the maximum number of versions of a managed policy.
+const iamMaxPolicyVersions = 5
+
+type iamRole struct {
+	arn                      string
+	assumeRolePolicyDocument string
+	attachedPolicyARNs       map[string]struct{}
+	createDate               time.Time
+	description              string
+	id                       string
+	inlinePolicies           map[string]string
+	maxSessionDuration       int
+	name                     string
+	path                     string
+	permissionsBoundaryARN   string
+	tags                     map[string]string
+}
+
+type iamPolicyVersion struct {
+	createDate time.Time
+	document   string
+	id         string
+}
+
+type iamPolicy struct {
+	arn              string
+	createDate       time.Time
+	defaultVersionID string
+	description      string
+	id               string
+	name             string
+	nextVersion      int
+	path             string
+	tags             map[string]string
+	updateDate       time.Time
+	versions         []*iamPolicyVersion
+}
+
+type iamService struct {
+	nextID   int
+	policies map[string]*iamPolicy
+	roles    map[string]*iamRole
+}
+
+func newIAMService(server *Server) *iamService {
+	return &iamService{
+		policies: map[string]*iamPolicy{},
+		roles:    map[string]*iamRole{},
+	}
+}
+
+func (svc *iamService) handle(w http.ResponseWriter, r *http.Request, region string) {
+	handleQuery(w, r, region, iamXMLNamespace, map[string]queryAction{
+		"AttachRolePolicy":              svc.attachRolePolicy,
+		"CreatePolicy":                  svc.createPolicy,
+		"CreatePolicyVersion":           svc.createPolicyVersion,
+		"CreateRole":                    svc.createRole,
+		"DeletePolicy":                  svc.deletePolicy,
+		"DeletePolicyVersion":           svc.deletePolicyVersion,
+		"DeleteRole":                    svc.deleteRole,
+		"DeleteRolePermissionsBoundary": svc.deleteRolePermissionsBoundary,
+		"DeleteRolePolicy":              svc.deleteRolePolicy,
+		"DetachRolePolicy":              svc.detachRolePolicy,
+		"GetPolicy":                     svc.getPolicy,
+		"GetPolicyVersion":              svc.getPolicyVersion,
+		"GetRole":                       svc.getRole,
+		"GetRolePolicy":                 svc.getRolePolicy,
+		"ListAttachedRolePolicies":      svc.listAttachedRolePolicies,
+		"ListInstanceProfilesForRole":   svc.listInstanceProfilesForRole,
+		"ListPolicyVersions":            svc.listPolicyVersions,
+		"ListRolePolicies":              svc.listRolePolicies,
+		"ListRoles":                     svc.listRoles,
+		"PutRolePermissionsBoundary":    svc.putRolePermissionsBoundary,
+		"PutRolePolicy":                 svc.putRolePolicy,
+		"TagPolicy":                     svc.tagPolicy,
+		"TagRole":                       svc.tagRole,
+		"UntagPolicy":                   svc.untagPolicy,
+		"UntagRole":                     svc.untagRole,
+		"UpdateAssumeRolePolicy":        svc.updateAssumeRolePolicy,
+		"UpdateRole":                    svc.updateRole,
+		"UpdateRoleDescription":         svc.updateRoleDescription,
+	})
+}
+
+// iamARN returns the ARN of an IAM entity. IAM ARNs do not include a region.
+func iamARN(resourceType, path, name string) string {
+	return fmt.Sprintf("arn:%s:iam::%s:%s%s%s", Partition, AccountID, resourceType, path, name)
+}
+
+// uniqueID returns a new IAM unique ID with the specified prefix, e.g. "AROA" for roles.
+func (svc *iamService) uniqueID(prefix string) string {
+	svc.nextID++
+
+	return fmt.Sprintf("%s%017d", prefix, svc.nextID)
+}
+
+func iamNoSuchEntityError(entityType, name string) error {
+	return newQueryError(http.StatusNotFound, "NoSuchEntity", "The %s with name %s cannot be found.", entityType, name)
+}
+
+func iamPath(form url.Values) (string, error) {
+	path := form.Get("Path")
+
+	if path == "" {
+		return "/", nil
+	}
+
+	if !strings.HasPrefix(path, "/") || !strings.HasSuffix(path, "/") {
+		return "", newQueryError(http.StatusBadRequest, "ValidationError", "The specified value for path is invalid. It must begin and end with / and contain only alphanumeric characters and/or / characters.")
+	}
+
+	return path, nil
+}
+
+func validatePolicyDocument(document string) error {
+	if !strings.HasPrefix(strings.TrimSpace(document), "{") {
+		return newQueryError(http.StatusBadRequest, "MalformedPolicyDocument", "Syntax errors in policy.")
+	}
+
+	return nil
+}
+
+type iamPermissionsBoundary struct {
+	PermissionsBoundaryArn  string
+	PermissionsBoundaryType string
+}
+
+type iamRoleXML struct {
+	Arn                      string
+	AssumeRolePolicyDocument string
+	CreateDate               time.Time
+	Description              string `xml:",omitempty"`
+	MaxSessionDuration       int
+	Path                     string
+	PermissionsBoundary      *iamPermissionsBoundary `xml:",omitempty"`
+	RoleId                   string
+	RoleName                 string
+	Tags                     struct {
+		Member []tag `xml:"member"`
+	}
+}
+
+func (role *iamRole) toXML() iamRoleXML {
+	v := iamRoleXML{
+		Arn: role.arn,
+		// IAM returns URL-encoded policy documents.
+		AssumeRolePolicyDocument: url.QueryEscape(role.assumeRolePolicyDocument),
+		CreateDate:               role.createDate,
+		Description:              role.description,
+		MaxSessionDuration:       role.maxSessionDuration,
+		Path:                     role.path,
+		RoleId:                   role.id,
+		RoleName:                 role.name,
+	}
+	v.Tags.Member = tagList(role.tags)
+
+	if role.permissionsBoundaryARN != "" {
+		v.PermissionsBoundary = &iamPermissionsBoundary{
+			PermissionsBoundaryArn:  role.permissionsBoundaryARN,
+			PermissionsBoundaryType: "Policy",
+		}
+	}
+
+	return v
+}
+
+func (svc *iamService) findRole(name string) (*iamRole, error) {
+	role, ok := svc.roles[name]
+
+	if !ok {
+		return nil, iamNoSuchEntityError("role", name)
+	}
+
+	return role, nil
+}
+
+func (svc *iamService) createRole(form url.Values, region string) (interface{}, error) {
+	name := form.Get("RoleName")
+
+	if _, ok := svc.roles[name]; ok {
+		return nil, newQueryError(http.StatusConflict, "EntityAlreadyExists", "Role with name %s already exists.", name)
+	}
+
+	path, err := iamPath(form)
+
+	if err != nil {
+		return nil, err
+	}
+
+	document := form.Get("AssumeRolePolicyDocument")
+
+	if err := validatePolicyDocument(document); err != nil {
+		return nil, err
+	}
+
+	maxSessionDuration := 3600
+
+	if v := form.Get("MaxSessionDuration"); v != "" {
+		maxSessionDuration, err = strconv.Atoi(v)
+
+		if err != nil || maxSessionDuration < 3600 || maxSessionDuration > 43200 {
+			return nil, newQueryError(http.StatusBadRequest, "ValidationError", "The requested DurationSeconds exceeds the MaxSessionDuration set for this role.")
+		}
+	}
+
+	role := &iamRole{
+		arn:                      iamARN("role", path, name),
+		assumeRolePolicyDocument: document,
+		attachedPolicyARNs:       map[string]struct{}{},
+		createDate:               time.Now().UTC().Truncate(time.Second),
+		description:              form.Get("Description"),
+		id:                       svc.uniqueID("AROA"),
+		inlinePolicies:           map[string]string{},
+		maxSessionDuration:       maxSessionDuration,
+		name:                     name,
+		path:                     path,
+		permissionsBoundaryARN:   form.Get("PermissionsBoundary"),
+		tags:                     queryMap(form, "Tags.member", "Key", "Value"),
+	}
+	svc.roles[name] = role
+
+	return struct{ Role iamRoleXML }{role.toXML()}, nil
+}
+
+func (svc *iamService) getRole(form url.Values, region string) (interface{}, error) {
+	role, err := svc.findRole(form.Get("RoleName"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return struct{ Role iamRoleXML }{role.toXML()}, nil
+}
+
+func (svc *iamService) listRoles(form url.Values, region string) (interface{}, error) {
+	pathPrefix := form.Get("PathPrefix")
+
+	if pathPrefix == "" {
+		pathPrefix = "/"
+	}
+
+	var result struct {
+		IsTruncated bool
+		Roles       struct {
+			Member []iamRoleXML `xml:"member"`
+		}
+	}
+
+	for _, role := range svc.roles {
+		if !strings.HasPrefix(role.path, pathPrefix) {
+			continue
+		}
+
+		// ListRoles does not return tags or permissions boundaries.
+		v := role.toXML()
+		v.PermissionsBoundary = nil
+		v.Tags.Member = nil
+		result.Roles.Member = append(result.Roles.Member, v)
+	}
+
+	sort.Slice(result.Roles.Member, func(i, j int) bool { return result.Roles.Member[i].RoleName < result.Roles.Member[j].RoleName })
+
+	return result, nil
+}
+
+func (svc *iamService) deleteRole(form url.Values, region string) (interface{}, error) {
+	role, err := svc.findRole(form.Get("RoleName"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(role.attachedPolicyARNs) > 0 {
+		return nil, newQueryError(http.StatusConflict, "DeleteConflict", "Cannot delete entity, must detach all policies first.")
+	}
+
+	if len(role.inlinePolicies) > 0 {
+		return nil, newQueryError(http.StatusConflict, "DeleteConflict", "Cannot delete entity, must delete policies first.")
+	}
+
+	delete(svc.roles, role.name)
+
+	return nil, nil
+}
+
+func (svc *iamService) updateAssumeRolePolicy(form url.Values, region string) (interface{}, error) {
+	role, err := svc.findRole(form.Get("RoleName"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	document := form.Get("PolicyDocument")
+
+	if err := validatePolicyDocument(document); err != nil {
+		return nil, err
+	}
+
+	role.assumeRolePolicyDocument = document
+
+	return nil, nil
+}
+
+func (svc *iamService) updateRole(form url.Values, region string) (interface{}, error) {
+	role, err := svc.findRole(form.Get("RoleName"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := form["Description"]; ok {
+		role.description = form.Get("Description")
+	}
+
+	if v := form.Get("MaxSessionDuration"); v != "" {
+		maxSessionDuration, err := strconv.Atoi(v)
+
+		if err != nil || maxSessionDuration < 3600 || maxSessionDuration > 43200 {
+			return nil, newQueryError(http.StatusBadRequest, "ValidationError", "The requested DurationSeconds exceeds the MaxSessionDuration set for this role.")
+		}
+
+		role.maxSessionDuration = maxSessionDuration
+	}
+
+	return struct{}{}, nil
+}
+
+func (svc *iamService) updateRoleDescription(form url.Values, region string) (interface{}, error) {
+	role, err := svc.findRole(form.Get("RoleName"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	role.description = form.Get("Description")
+
+	return struct{ Role iamRoleXML }{role.toXML()}, nil
+}
+
+func (svc *iamService) putRolePermissionsBoundary(form url.Values, region string) (interface{}, error) {
+	role, err := svc.findRole(form.Get("RoleName"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	role.permissionsBoundaryARN = form.Get("PermissionsBoundary")
+
+	return nil, nil
+}
+
+func (svc *iamService) deleteRolePermissionsBoundary(form url.Values, region string) (interface{}, error) {
+	role, err := svc.findRole(form.Get("RoleName"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	role.permissionsBoundaryARN = ""
+
+	return nil, nil
+}
+
+func (svc *iamService) tagRole(form url.Values, region string) (interface{}, error) {
+	role, err := svc.findRole(form.Get("RoleName"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range queryMap(form, "Tags.member", "Key", "Value") {
+		role.tags[k] = v
+	}
+
+	return nil, nil
+}
+
+func (svc *iamService) untagRole(form url.Values, region string) (interface{}, error) {
+	role, err := svc.findRole(form.Get("RoleName"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, k := range queryList(form, "TagKeys.member") {
+		delete(role.tags, k)
+	}
+
+	return nil, nil
+}
+
+func (svc *iamService) putRolePolicy(form url.Values, region string) (interface{}, error) {
+	role, err := svc.findRole(form.Get("RoleName"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	document := form.Get("PolicyDocument")
+
+	if err := validatePolicyDocument(document); err != nil {
+		return nil, err
+	}
+
+	role.inlinePolicies[form.Get("PolicyName")] = document
+
+	return nil, nil
+}
+
+func (svc *iamService) getRolePolicy(form url.Values, region string) (interface{}, error) {
+	role, err := svc.findRole(form.Get("RoleName"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	name := form.Get("PolicyName")
+	document, ok := role.inlinePolicies[name]
+
+	if !ok {
+		return nil, iamNoSuchEntityError("role policy", name)
+	}
+
+	return struct {
+		PolicyDocument string
+		PolicyName     string
+		RoleName       string
+	}{
+		PolicyDocument: url.QueryEscape(document),
+		PolicyName:     name,
+		RoleName:       role.name,
+	}, nil
+}
+
+func (svc *iamService) deleteRolePolicy(form url.Values, region string) (interface{}, error) {
+	role, err := svc.findRole(form.Get("RoleName"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	name := form.Get("PolicyName")
+
+	if _, ok := role.inlinePolicies[name]; !ok {
+		return nil, iamNoSuchEntityError("role policy", name)
+	}
+
+	delete(role.inlinePolicies, name)
+
+	return nil, nil
+}
+
+func (svc *iamService) listRolePolicies(form url.Values, region string) (interface{}, error) {
+	role, err := svc.findRole(form.Get("RoleName"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		IsTruncated bool
+		PolicyNames struct {
+			Member []string `xml:"member"`
+		}
+	}
+
+	for name := range role.inlinePolicies {
+		result.PolicyNames.Member = append(result.PolicyNames.Member, name)
+	}
+
+	sort.Strings(result.PolicyNames.Member)
+
+	return result, nil
+}
+
+func (svc *iamService) attachRolePolicy(form url.Values, region string) (interface{}, error) {
+	role, err := svc.findRole(form.Get("RoleName"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	policyARN := form.Get("PolicyArn")
+
+	// AWS managed policies are not modelled and can always be attached.
+	if !strings.HasPrefix(policyARN, fmt.Sprintf("arn:%s:iam::aws:policy/", Partition)) {
+		if _, ok := svc.policies[policyARN]; !ok {
+			return nil, newQueryError(http.StatusNotFound, "NoSuchEntity", "Policy %s does not exist or is not attachable.", policyARN)
+		}
+	}
+
+	role.attachedPolicyARNs[policyARN] = struct{}{}
+
+	return nil, nil
+}
+
+func (svc *iamService) detachRolePolicy(form url.Values, region string) (interface{}, error) {
+	role, err := svc.findRole(form.Get("RoleName"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	policyARN := form.Get("PolicyArn")
+
+	if _, ok := role.attachedPolicyARNs[policyARN]; !ok {
+		return nil, newQueryError(http.StatusNotFound, "NoSuchEntity", "Policy %s was not found.", policyARN)
+	}
+
+	delete(role.attachedPolicyARNs, policyARN)
+
+	return nil, nil
+}
+
+type iamAttachedPolicy struct {
+	PolicyArn  string
+	PolicyName string
+}
+
+func (svc *iamService) listAttachedRolePolicies(form url.Values, region string) (interface{}, error) {
+	role, err := svc.findRole(form.Get("RoleName"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		AttachedPolicies struct {
+			Member []iamAttachedPolicy `xml:"member"`
+		}
+		IsTruncated bool
+	}
+
+	for policyARN := range role.attachedPolicyARNs {
+		result.AttachedPolicies.Member = append(result.AttachedPolicies.Member, iamAttachedPolicy{
+			PolicyArn:  policyARN,
+			PolicyName: policyARN[strings.LastIndex(policyARN, "/")+1:],
+		})
+	}
+
+	sort.Slice(result.AttachedPolicies.Member, func(i, j int) bool {
+		return result.AttachedPolicies.Member[i].PolicyArn < result.AttachedPolicies.Member[j].PolicyArn
+	})
+
+	return result, nil
+}
+
+func (svc *iamService) listInstanceProfilesForRole(form url.Values, region string) (interface{}, error) {
+	if _, err := svc.findRole(form.Get("RoleName")); err != nil {
+		return nil, err
+	}
+
+	// Instance profiles are not modelled.
+	return struct {
+		InstanceProfiles struct{}
+		IsTruncated      bool
+	}{}, nil
+}
+
+type iamPolicyXML struct {
+	Arn              string
+	AttachmentCount  int
+	CreateDate       time.Time
+	DefaultVersionId string
+	Description      string `xml:",omitempty"`
+	IsAttachable     bool
+	Path             string
+	PolicyId         string
+	PolicyName       string
+	Tags             struct {
+		Member []tag `xml:"member"`
+	}
+	UpdateDate time.Time
+}
+
+type iamPolicyVersionXML struct {
+	CreateDate       time.Time
+	Document         string `xml:",omitempty"`
+	IsDefaultVersion bool
+	VersionId        string
+}
+
+func (svc *iamService) policyXML(policy *iamPolicy) iamPolicyXML {
+	v := iamPolicyXML{
+		Arn:              policy.arn,
+		CreateDate:       policy.createDate,
+		DefaultVersionId: policy.defaultVersionID,
+		Description:      policy.description,
+		IsAttachable:     true,
+		Path:             policy.path,
+		PolicyId:         policy.id,
+		PolicyName:       policy.name,
+		UpdateDate:       policy.updateDate,
+	}
+	v.Tags.Member = tagList(policy.tags)
+
+	for _, role := range svc.roles {
+		if _, ok := role.attachedPolicyARNs[policy.arn]; ok {
+			v.AttachmentCount++
+		}
+	}
+
+	return v
+}
+
+func (policy *iamPolicy) versionXML(version *iamPolicyVersion, withDocument bool) iamPolicyVersionXML {
+	v := iamPolicyVersionXML{
+		CreateDate:       version.createDate,
+		IsDefaultVersion: version.id == policy.defaultVersionID,
+		VersionId:        version.id,
+	}
+
+	if withDocument {
+		v.Document = url.QueryEscape(version.document)
+	}
+
+	return v
+}
+
+func (policy *iamPolicy) addVersion(document string, setAsDefault bool) *iamPolicyVersion {
+	policy.nextVersion++
+
+	version := &iamPolicyVersion{
+		createDate: time.Now().UTC().Truncate(time.Second),
+		document:   document,
+		id:         fmt.Sprintf("v%d", policy.nextVersion),
+	}
+	policy.versions = append(policy.versions, version)
+	policy.updateDate = version.createDate
+
+	if setAsDefault {
+		policy.defaultVersionID = version.id
+	}
+
+	return version
+}
+
+func (svc *iamService) findPolicy(policyARN string) (*iamPolicy, error) {
+	policy, ok := svc.policies[policyARN]
+
+	if !ok {
+		return nil, newQueryError(http.StatusNotFound, "NoSuchEntity", "Policy %s does not exist or is not attachable.", policyARN)
+	}
+
+	return policy, nil
+}
+
+func (svc *iamService) createPolicy(form url.Values, region string) (interface{}, error) {
+	name := form.Get("PolicyName")
+	path, err := iamPath(form)
+
+	if err != nil {
+		return nil, err
+	}
+
+	policyARN := iamARN("policy", path, name)
+
+	if _, ok := svc.policies[policyARN]; ok {
+		return nil, newQueryError(http.StatusConflict, "EntityAlreadyExists", "A policy called %s already exists. Duplicate names are not allowed.", name)
+	}
+
+	document := form.Get("PolicyDocument")
+
+	if err := validatePolicyDocument(document); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+	policy := &iamPolicy{
+		arn:         policyARN,
+		createDate:  now,
+		description: form.Get("Description"),
+		id:          svc.uniqueID("ANPA"),
+		name:        name,
+		path:        path,
+		tags:        queryMap(form, "Tags.member", "Key", "Value"),
+	}
+	policy.addVersion(document, true)
+	svc.policies[policyARN] = policy
+
+	return struct{ Policy iamPolicyXML }{svc.policyXML(policy)}, nil
+}
+
+func (svc *iamService) getPolicy(form url.Values, region string) (interface{}, error) {
+	policy, err := svc.findPolicy(form.Get("PolicyArn"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return struct{ Policy iamPolicyXML }{svc.policyXML(policy)}, nil
+}
+
+func (svc *iamService) deletePolicy(form url.Values, region string) (interface{}, error) {
+	policy, err := svc.findPolicy(form.Get("PolicyArn"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if svc.policyXML(policy).AttachmentCount > 0 {
+		return nil, newQueryError(http.StatusConflict, "DeleteConflict", "Cannot delete a policy attached to entities.")
+	}
+
+	if len(policy.versions) > 1 {
+		return nil, newQueryError(http.StatusConflict, "DeleteConflict", "This policy has more than one version. Before you delete a policy, you must delete the policy's versions. The default version is deleted with the policy.")
+	}
+
+	delete(svc.policies, policy.arn)
+
+	return nil, nil
+}
+
+func (svc *iamService) createPolicyVersion(form url.Values, region string) (interface{}, error) {
+	policy, err := svc.findPolicy(form.Get("PolicyArn"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(policy.versions) >= iamMaxPolicyVersions {
+		return nil, newQueryError(http.StatusConflict, "LimitExceeded", "A managed policy can have up to %d versions. Before you create a new version, you must delete an existing version.", iamMaxPolicyVersions)
+	}
+
+	document := form.Get("PolicyDocument")
+
+	if err := validatePolicyDocument(document); err != nil {
+		return nil, err
+	}
+
+	version := policy.addVersion(document, form.Get("SetAsDefault") == "true")
+
+	return struct{ PolicyVersion iamPolicyVersionXML }{policy.versionXML(version, false)}, nil
+}
+
+func (svc *iamService) getPolicyVersion(form url.Values, region string) (interface{}, error) {
+	policy, err := svc.findPolicy(form.Get("PolicyArn"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	versionID := form.Get("VersionId")
+
+	for _, version := range policy.versions {
+		if version.id == versionID {
+			return struct{ PolicyVersion iamPolicyVersionXML }{policy.versionXML(version, true)}, nil
+		}
+	}
+
+	return nil, newQueryError(http.StatusNotFound, "NoSuchEntity", "Policy %s version %s does not exist or is not attachable.", policy.arn, versionID)
+}
+
+func (svc *iamService) deletePolicyVersion(form url.Values, region string) (interface{}, error) {
+	policy, err := svc.findPolicy(form.Get("PolicyArn"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	versionID := form.Get("VersionId")
+
+	if versionID == policy.defaultVersionID {
+		return nil, newQueryError(http.StatusConflict, "DeleteConflict", "Cannot delete the default version of a policy.")
+	}
+
+	for i, version := range policy.versions {
+		if version.id == versionID {
+			policy.versions = append(policy.versions[:i], policy.versions[i+1:]...)
+
+			return nil, nil
+		}
+	}
+
+	return nil, newQueryError(http.StatusNotFound, "NoSuchEntity", "Policy %s version %s does not exist.", policy.arn, versionID)
+}
+
+func (svc *iamService) listPolicyVersions(form url.Values, region string) (interface{}, error) {
+	policy, err := svc.findPolicy(form.Get("PolicyArn"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		IsTruncated bool
+		Versions    struct {
+			Member []iamPolicyVersionXML `xml:"member"`
+		}
+	}
+
+	for _, version := range policy.versions {
+		result.Versions.Member = append(result.Versions.Member, policy.versionXML(version, false))
+	}
+
+	return result, nil
+}
+
+func (svc *iamService) tagPolicy(form url.Values, region string) (interface{}, error) {
+	policy, err := svc.findPolicy(form.Get("PolicyArn"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range queryMap(form, "Tags.member", "Key", "Value") {
+		policy.tags[k] = v
+	}
+
+	return nil, nil
+}
+
+func (svc *iamService) untagPolicy(form url.Values, region string) (interface{}, error) {
+	policy, err := svc.findPolicy(form.Get("PolicyArn"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, k := range queryList(form, "TagKeys.member") {
+		delete(policy.tags, k)
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,197 @@
+package fakeaws
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+)
+
+// queryError is an AWS Query protocol error.
+type queryError struct {
+	StatusCode int
+	Code       string
+	Message    string
+}
+
+func (e *queryError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+func newQueryError(statusCode int, code, format string, a ...interface{}) *queryError {
+	return &queryError{
+		StatusCode: statusCode,
+		Code:       code,
+		Message:    fmt.Sprintf(format, a...),
+	}
+}
+
+// queryAction handles an AWS Query protocol action, returning the action's result element.
+type queryAction func(form url.Values, region string) (interface{}, error)
+
+// handleQuery handles an AWS Query protocol request by dispatching it to the action named in the request.
+func handleQuery(w http.ResponseWriter, r *http.Request, region, xmlns string, actions map[string]queryAction) {
+	if err := r.ParseForm(); err != nil {
+		writeQueryError(w, newQueryError(http.StatusBadRequest, "MalformedQueryString", "%s", err))
+		return
+	}
+
+	name := r.Form.Get("Action")
+	action, ok := actions[name]
+
+	if !ok {
+		writeQueryError(w, newQueryError(http.StatusNotImplemented, "InvalidAction", "The action %s is not valid for this endpoint.", name))
+		return
+	}
+
+	result, err := action(r.Form, region)
+
+	if err != nil {
+		qErr, ok := err.(*queryError)
+
+		if !ok {
+			qErr = newQueryError(http.StatusInternalServerError, "InternalFailure", "%s", err)
+		}
+
+		writeQueryError(w, qErr)
+		return
+	}
+
+	var buf bytes.Buffer
+	enc := xml.NewEncoder(&buf)
+	responseElement := xml.StartElement{
+		Name: xml.Name{Local: name + "Response"},
+		Attr: []xml.Attr{{Name: xml.Name{Local: "xmlns"}, Value: xmlns}},
+	}
+
+	err = enc.EncodeToken(responseElement)
+
+	if err == nil && result != nil {
+		err = enc.EncodeElement(result, xml.StartElement{Name: xml.Name{Local: name + "Result"}})
+	}
+
+	if err == nil {
+		err = enc.EncodeElement(struct{ RequestId string }{w.Header().Get("X-Amzn-Requestid")}, xml.StartElement{Name: xml.Name{Local: "ResponseMetadata"}})
+	}
+
+	if err == nil {
+		err = enc.EncodeToken(responseElement.End())
+	}
+
+	if err == nil {
+		err = enc.Flush()
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeXMLBytes(w, http.StatusOK, buf.Bytes())
+}
+
+func writeQueryError(w http.ResponseWriter, err *queryError) {
+	errorType := "Sender"
+
+	if err.StatusCode >= http.StatusInternalServerError {
+		errorType = "Receiver"
+	}
+
+	response := struct {
+		XMLName xml.Name `xml:"ErrorResponse"`
+		Error   struct {
+			Type    string
+			Code    string
+			Message string
+		}
+		RequestId string
+	}{
+		RequestId: w.Header().Get("X-Amzn-Requestid"),
+	}
+	response.Error.Type = errorType
+	response.Error.Code = err.Code
+	response.Error.Message = err.Message
+
+	writeXML(w, err.StatusCode, response)
+}
+
+func writeXML(w http.ResponseWriter, statusCode int, v interface{}) {
+	b, err := xml.Marshal(v)
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeXMLBytes(w, statusCode, b)
+}
+
+func writeXMLBytes(w http.ResponseWriter, statusCode int, b []byte) {
+	w.Header().Set("Content-Type", "text/xml")
+	w.WriteHeader(statusCode)
+	w.Write([]byte(xml.Header)) //nolint:errcheck
+	w.Write(b)                  //nolint:errcheck
+}
+
+// queryList returns the values of an AWS Query protocol list parameter, e.g. "TagKey.1", "TagKey.2".
+func queryList(form url.Values, prefix string) []string {
+	var values []string
+
+	for i := 1; ; i++ {
+		key := prefix + "." + strconv.Itoa(i)
+
+		if _, ok := form[key]; !ok {
+			return values
+		}
+
+		values = append(values, form.Get(key))
+	}
+}
+
+// queryMap returns the entries of an AWS Query protocol map or list of key/value structures,
+// e.g. "Tag.1.Key" and "Tag.1.Value".
+func queryMap(form url.Values, prefix, keyName, valueName string) map[string]string {
+	m := map[string]string{}
+
+	for i := 1; ; i++ {
+		key := fmt.Sprintf("%s.%d.%s", prefix, i, keyName)
+
+		if _, ok := form[key]; !ok {
+			return m
+		}
+
+		m[form.Get(key)] = form.Get(fmt.Sprintf("%s.%d.%s", prefix, i, valueName))
+	}
+}
+
+// tag is an AWS Query or REST-XML protocol tag.
+type tag struct {
+	Key   string
+	Value string
+}
+
+// tagList returns tags sorted by key.
+func tagList(tags map[string]string) []tag {
+	list := make([]tag, 0, len(tags))
+
+	for k, v := range tags {
+		list = append(list, tag{Key: k, Value: v})
+	}
+
+	sort.Slice(list, func(i, j int) bool { return list[i].Key < list[j].Key })
+
+	return list
+}
+
+func copyMap(m map[string]string) map[string]string {
+	c := make(map[string]string, len(m))
+
+	for k, v := range m {
+		c[k] = v
+	}
+
+	return c
+}
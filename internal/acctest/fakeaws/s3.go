@@ -0,0 +1,314 @@
+package fakeaws
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+const s3XMLNamespace = "http://s3.amazonaws.com/doc/2006-03-01/"
+
+// s3Subresource describes a bucket configuration subresource, e.g. "?cors".
+// Configurations are stored as the request body of the subresource's Put operation,
+// which has the same structure as the response body of its Get operation.
+type s3Subresource struct {
+	// defaultBody is the response body of the Get operation for an unconfigured subresource.
+	defaultBody string
+
+	// notFoundCode and notFoundMessage are returned by the Get operation for an unconfigured subresource
+	// without a default body.
+	notFoundCode    string
+	notFoundMessage string
+}
+
+var s3BucketSubresources = map[string]s3Subresource{
+	"accelerate": {
+		defaultBody: `<AccelerateConfiguration xmlns="` + s3XMLNamespace + `"/>`,
+	},
+	"acl": {
+		defaultBody: `<AccessControlPolicy xmlns="` + s3XMLNamespace + `"><Owner><ID>` + s3CanonicalUserID + `</ID></Owner><AccessControlList><Grant><Grantee xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="CanonicalUser"><ID>` + s3CanonicalUserID + `</ID></Grantee><Permission>FULL_CONTROL</Permission></Grant></AccessControlList></AccessControlPolicy>`,
+	},
+	"cors": {
+		notFoundCode:    "NoSuchCORSConfiguration",
+		notFoundMessage: "The CORS configuration does not exist",
+	},
+	"encryption": {
+		notFoundCode:    "ServerSideEncryptionConfigurationNotFoundError",
+		notFoundMessage: "The server side encryption configuration was not found",
+	},
+	"lifecycle": {
+		notFoundCode:    "NoSuchLifecycleConfiguration",
+		notFoundMessage: "The lifecycle configuration does not exist",
+	},
+	"logging": {
+		defaultBody: `<BucketLoggingStatus xmlns="` + s3XMLNamespace + `"/>`,
+	},
+	"object-lock": {
+		notFoundCode:    "ObjectLockConfigurationNotFoundError",
+		notFoundMessage: "Object Lock configuration does not exist for this bucket",
+	},
+	"policy": {
+		notFoundCode:    "NoSuchBucketPolicy",
+		notFoundMessage: "The bucket policy does not exist",
+	},
+	"replication": {
+		notFoundCode:    "ReplicationConfigurationNotFoundError",
+		notFoundMessage: "The replication configuration was not found",
+	},
+	"requestPayment": {
+		defaultBody: `<RequestPaymentConfiguration xmlns="` + s3XMLNamespace + `"><Payer>BucketOwner</Payer></RequestPaymentConfiguration>`,
+	},
+	"tagging": {
+		notFoundCode:    "NoSuchTagSet",
+		notFoundMessage: "The TagSet does not exist",
+	},
+	"versioning": {
+		defaultBody: `<VersioningConfiguration xmlns="` + s3XMLNamespace + `"/>`,
+	},
+	"website": {
+		notFoundCode:    "NoSuchWebsiteConfiguration",
+		notFoundMessage: "The specified bucket does not have a website configuration",
+	},
+}
+
+const s3CanonicalUserID = "79a59df900b949e55d96a1e698fbacedfd6e09d98eacf8f8d5218e7cd47ef2be"
+
+type s3Bucket struct {
+	creationDate   time.Time
+	name           string
+	region         string
+	configurations map[string][]byte
+}
+
+type s3Service struct {
+	buckets map[string]*s3Bucket
+}
+
+func newS3Service(server *Server) *s3Service {
+	return &s3Service{
+		buckets: map[string]*s3Bucket{},
+	}
+}
+
+type s3Error struct {
+	statusCode int
+	code       string
+	message    string
+}
+
+func writeS3Error(w http.ResponseWriter, r *http.Request, err *s3Error) {
+	// HEAD responses have no body.
+	if r.Method == http.MethodHead {
+		w.WriteHeader(err.statusCode)
+		return
+	}
+
+	writeXML(w, err.statusCode, struct {
+		XMLName   xml.Name `xml:"Error"`
+		Code      string
+		Message   string
+		RequestId string
+	}{
+		Code:      err.code,
+		Message:   err.message,
+		RequestId: w.Header().Get("X-Amz-Request-Id"),
+	})
+}
+
+func (svc *s3Service) handle(w http.ResponseWriter, r *http.Request, region string) {
+	// Buckets are addressed using path-style requests.
+	path := strings.TrimPrefix(r.URL.Path, "/")
+
+	if path == "" {
+		if r.Method != http.MethodGet {
+			writeS3Error(w, r, &s3Error{http.StatusMethodNotAllowed, "MethodNotAllowed", "The specified method is not allowed against this resource."})
+			return
+		}
+
+		svc.listBuckets(w)
+		return
+	}
+
+	if strings.Contains(path, "/") {
+		writeS3Error(w, r, &s3Error{http.StatusNotImplemented, "NotImplemented", "Object operations are not implemented."})
+		return
+	}
+
+	name := path
+
+	if r.Method == http.MethodPut && len(r.URL.Query()) == 0 {
+		svc.createBucket(w, r, name, region)
+		return
+	}
+
+	bucket, ok := svc.buckets[name]
+
+	if !ok {
+		writeS3Error(w, r, &s3Error{http.StatusNotFound, "NoSuchBucket", "The specified bucket does not exist"})
+		return
+	}
+
+	query := r.URL.Query()
+
+	if _, ok := query["location"]; ok && r.Method == http.MethodGet {
+		location := bucket.region
+
+		// Buckets in us-east-1 have a null location constraint.
+		if location == "us-east-1" { //lintignore:AWSAT003
+			location = ""
+		}
+
+		writeXML(w, http.StatusOK, struct {
+			XMLName            xml.Name `xml:"LocationConstraint"`
+			Xmlns              string   `xml:"xmlns,attr"`
+			LocationConstraint string   `xml:",chardata"`
+		}{Xmlns: s3XMLNamespace, LocationConstraint: location})
+		return
+	}
+
+	for k := range query {
+		if subresource, ok := s3BucketSubresources[k]; ok {
+			svc.handleSubresource(w, r, bucket, k, subresource)
+			return
+		}
+	}
+
+	if len(query) > 0 {
+		writeS3Error(w, r, &s3Error{http.StatusNotImplemented, "NotImplemented", "A header or query you provided implies functionality that is not implemented."})
+		return
+	}
+
+	switch r.Method {
+	case http.MethodHead:
+		w.Header().Set("X-Amz-Bucket-Region", bucket.region)
+		w.WriteHeader(http.StatusOK)
+	case http.MethodDelete:
+		delete(svc.buckets, bucket.name)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeS3Error(w, r, &s3Error{http.StatusNotImplemented, "NotImplemented", "Object listing is not implemented."})
+	}
+}
+
+func (svc *s3Service) createBucket(w http.ResponseWriter, r *http.Request, name, region string) {
+	if _, ok := svc.buckets[name]; ok {
+		writeS3Error(w, r, &s3Error{http.StatusConflict, "BucketAlreadyOwnedByYou", "Your previous request to create the named bucket succeeded and you already own it."})
+		return
+	}
+
+	var configuration struct {
+		LocationConstraint string
+	}
+
+	if body, err := io.ReadAll(r.Body); err == nil && len(body) > 0 {
+		if err := xml.Unmarshal(body, &configuration); err != nil {
+			writeS3Error(w, r, &s3Error{http.StatusBadRequest, "MalformedXML", "The XML you provided was not well-formed or did not validate against our published schema"})
+			return
+		}
+	}
+
+	bucketRegion := configuration.LocationConstraint
+
+	if bucketRegion == "" {
+		bucketRegion = "us-east-1" //lintignore:AWSAT003
+	}
+
+	if bucketRegion != region {
+		writeS3Error(w, r, &s3Error{http.StatusBadRequest, "IllegalLocationConstraintException", fmt.Sprintf("The %s location constraint is incompatible for the region specific endpoint this request was sent to.", bucketRegion)})
+		return
+	}
+
+	bucket := &s3Bucket{
+		creationDate:   time.Now().UTC().Truncate(time.Second),
+		name:           name,
+		region:         bucketRegion,
+		configurations: map[string][]byte{},
+	}
+
+	// Object Lock can only be enabled on bucket creation and requires versioning.
+	if strings.EqualFold(r.Header.Get("X-Amz-Bucket-Object-Lock-Enabled"), "true") {
+		bucket.configurations["object-lock"] = []byte(`<ObjectLockConfiguration xmlns="` + s3XMLNamespace + `"><ObjectLockEnabled>Enabled</ObjectLockEnabled></ObjectLockConfiguration>`)
+		bucket.configurations["versioning"] = []byte(`<VersioningConfiguration xmlns="` + s3XMLNamespace + `"><Status>Enabled</Status></VersioningConfiguration>`)
+	}
+
+	svc.buckets[name] = bucket
+
+	w.Header().Set("Location", "/"+name)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (svc *s3Service) handleSubresource(w http.ResponseWriter, r *http.Request, bucket *s3Bucket, name string, subresource s3Subresource) {
+	switch r.Method {
+	case http.MethodGet:
+		body, ok := bucket.configurations[name]
+
+		if !ok && subresource.defaultBody == "" {
+			writeS3Error(w, r, &s3Error{http.StatusNotFound, subresource.notFoundCode, subresource.notFoundMessage})
+			return
+		}
+
+		if !ok {
+			body = []byte(subresource.defaultBody)
+		}
+
+		if name == "policy" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			w.Write(body) //nolint:errcheck
+			return
+		}
+
+		writeXMLBytes(w, http.StatusOK, body)
+	case http.MethodPut:
+		body, err := io.ReadAll(r.Body)
+
+		if err != nil {
+			writeS3Error(w, r, &s3Error{http.StatusBadRequest, "IncompleteBody", err.Error()})
+			return
+		}
+
+		// Canned ACLs are specified in a header and are not modelled.
+		if len(body) > 0 {
+			bucket.configurations[name] = body
+		}
+
+		w.WriteHeader(http.StatusOK)
+	case http.MethodDelete:
+		delete(bucket.configurations, name)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeS3Error(w, r, &s3Error{http.StatusMethodNotAllowed, "MethodNotAllowed", "The specified method is not allowed against this resource."})
+	}
+}
+
+func (svc *s3Service) listBuckets(w http.ResponseWriter) {
+	type bucketXML struct {
+		CreationDate time.Time
+		Name         string
+	}
+
+	var result struct {
+		XMLName xml.Name `xml:"ListAllMyBucketsResult"`
+		Xmlns   string   `xml:"xmlns,attr"`
+		Buckets struct {
+			Bucket []bucketXML
+		}
+		Owner struct {
+			ID string
+		}
+	}
+	result.Xmlns = s3XMLNamespace
+	result.Owner.ID = s3CanonicalUserID
+
+	for _, bucket := range svc.buckets {
+		result.Buckets.Bucket = append(result.Buckets.Bucket, bucketXML{CreationDate: bucket.creationDate, Name: bucket.name})
+	}
+
+	sort.Slice(result.Buckets.Bucket, func(i, j int) bool { return result.Buckets.Bucket[i].Name < result.Buckets.Bucket[j].Name })
+
+	writeXML(w, http.StatusOK, result)
+}
@@ -0,0 +1,99 @@
+// Package fakeaws implements an in-process fake AWS endpoint for offline testing of resource CRUD functions.
+//
+// The fake endpoint serves a subset of the control plane APIs of SQS, SNS, IAM, S3 and DynamoDB,
+// keeping state in memory. Requests are routed to a service by the service name in the
+// Signature Version 4 credential scope of the request's Authorization header, so the same URL
+// can be used as the custom endpoint of every service.
+//
+// Resources are created in a fixed account and are immediately available; there is no eventual consistency.
+// Operations that are not implemented return an error with HTTP status code 501.
+package fakeaws
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync"
+	"testing"
+)
+
+const (
+	// AccountID is the AWS account ID in which all resources are created.
+	AccountID = "123456789012"
+
+	// Partition is the AWS partition of the fake endpoint.
+	Partition = "aws"
+)
+
+var credentialScopeRegexp = regexp.MustCompile(`Credential=[^/]+/\d{8}/([^/]+)/([^/]+)/aws4_request`)
+
+// service handles requests for an AWS service.
+// Handlers are called with the server's lock held.
+type service interface {
+	handle(w http.ResponseWriter, r *http.Request, region string)
+}
+
+// Server is an in-process fake AWS endpoint.
+type Server struct {
+	*httptest.Server
+
+	mu        sync.Mutex
+	requestID int
+	services  map[string]service
+}
+
+// NewServer starts a fake AWS endpoint which is closed when the test completes.
+func NewServer(t *testing.T) *Server {
+	s := &Server{}
+
+	s.services = map[string]service{
+		"dynamodb": newDynamoDBService(s),
+		"iam":      newIAMService(s),
+		"s3":       newS3Service(s),
+		"sns":      newSNSService(s),
+		"sqs":      newSQSService(s),
+	}
+
+	s.Server = httptest.NewServer(s)
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+// Services returns the signing names of the services served by the endpoint.
+func (s *Server) Services() []string {
+	return []string{"dynamodb", "iam", "s3", "sns", "sqs"}
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requestID++
+	requestID := fmt.Sprintf("00000000-0000-0000-0000-%012d", s.requestID)
+	w.Header().Set("X-Amzn-Requestid", requestID)
+	w.Header().Set("X-Amz-Request-Id", requestID)
+
+	m := credentialScopeRegexp.FindStringSubmatch(r.Header.Get("Authorization"))
+
+	if m == nil {
+		http.Error(w, "missing or invalid Signature Version 4 Authorization header", http.StatusForbidden)
+		return
+	}
+
+	region, name := m[1], m[2]
+	svc, ok := s.services[name]
+
+	if !ok {
+		http.Error(w, fmt.Sprintf("service %q is not implemented", name), http.StatusNotImplemented)
+		return
+	}
+
+	svc.handle(w, r, region)
+}
+
+// arn returns the ARN of a resource in the fake account.
+func arn(service, region, resource string) string {
+	return fmt.Sprintf("arn:%s:%s:%s:%s:%s", Partition, service, region, AccountID, resource)
+}
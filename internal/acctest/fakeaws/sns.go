@@ -0,0 +1,212 @@
+package fakeaws
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+const snsXMLNamespace = "http://sns.amazonaws.com/doc/2010-03-31/"
+
+type snsTopic struct {
+	arn        string
+	attributes map[string]string
+	tags       map[string]string
+}
+
+type snsService struct {
+	topics map[string]*snsTopic
+}
+
+func newSNSService(server *Server) *snsService {
+	return &snsService{
+		topics: map[string]*snsTopic{},
+	}
+}
+
+func (svc *snsService) handle(w http.ResponseWriter, r *http.Request, region string) {
+	handleQuery(w, r, region, snsXMLNamespace, map[string]queryAction{
+		"CreateTopic":         svc.createTopic,
+		"DeleteTopic":         svc.deleteTopic,
+		"GetTopicAttributes":  svc.getTopicAttributes,
+		"ListTagsForResource": svc.listTagsForResource,
+		"ListTopics":          svc.listTopics,
+		"SetTopicAttributes":  svc.setTopicAttributes,
+		"TagResource":         svc.tagResource,
+		"UntagResource":       svc.untagResource,
+	})
+}
+
+type snsAttributeEntry struct {
+	Key   string `xml:"key"`
+	Value string `xml:"value"`
+}
+
+type snsAttributes struct {
+	Entry []snsAttributeEntry `xml:"entry"`
+}
+
+func snsDefaultTopicPolicy(topicARN string) string {
+	return fmt.Sprintf(`{"Version":"2008-10-17","Id":"__default_policy_ID","Statement":[{"Sid":"__default_statement_ID","Effect":"Allow","Principal":{"AWS":"*"},"Action":["SNS:GetTopicAttributes","SNS:SetTopicAttributes","SNS:AddPermission","SNS:RemovePermission","SNS:DeleteTopic","SNS:Subscribe","SNS:ListSubscriptionsByTopic","SNS:Publish"],"Resource":%q,"Condition":{"StringEquals":{"AWS:SourceOwner":%q}}}]}`, topicARN, AccountID)
+}
+
+func (svc *snsService) createTopic(form url.Values, region string) (interface{}, error) {
+	name := form.Get("Name")
+	attributes := queryMap(form, "Attributes.entry", "key", "value")
+
+	if name == "" {
+		return nil, newQueryError(http.StatusBadRequest, "InvalidParameter", "Invalid parameter: Topic Name")
+	}
+
+	if fifo := attributes["FifoTopic"] == "true"; fifo != strings.HasSuffix(name, ".fifo") {
+		return nil, newQueryError(http.StatusBadRequest, "InvalidParameter", "Invalid parameter: Topic Name")
+	}
+
+	topicARN := arn("sns", region, name)
+
+	if topic, ok := svc.topics[topicARN]; ok {
+		return struct{ TopicArn string }{topic.arn}, nil
+	}
+
+	attributes["DisplayName"] = ""
+	attributes["EffectiveDeliveryPolicy"] = `{"http":{"defaultHealthyRetryPolicy":{"minDelayTarget":20,"maxDelayTarget":20,"numRetries":3,"numMaxDelayRetries":0,"numNoDelayRetries":0,"numMinDelayRetries":0,"backoffFunction":"linear"},"disableSubscriptionOverrides":false}}`
+	attributes["Owner"] = AccountID
+	attributes["Policy"] = snsDefaultTopicPolicy(topicARN)
+	attributes["SubscriptionsConfirmed"] = "0"
+	attributes["SubscriptionsDeleted"] = "0"
+	attributes["SubscriptionsPending"] = "0"
+	attributes["TopicArn"] = topicARN
+
+	svc.topics[topicARN] = &snsTopic{
+		arn:        topicARN,
+		attributes: attributes,
+		tags:       queryMap(form, "Tags.member", "Key", "Value"),
+	}
+
+	return struct{ TopicArn string }{topicARN}, nil
+}
+
+func (svc *snsService) findTopic(topicARN string) (*snsTopic, error) {
+	topic, ok := svc.topics[topicARN]
+
+	if !ok {
+		return nil, newQueryError(http.StatusNotFound, "NotFound", "Topic does not exist")
+	}
+
+	return topic, nil
+}
+
+func (svc *snsService) deleteTopic(form url.Values, region string) (interface{}, error) {
+	// Deleting a topic that does not exist does not result in an error.
+	delete(svc.topics, form.Get("TopicArn"))
+
+	return nil, nil
+}
+
+func (svc *snsService) getTopicAttributes(form url.Values, region string) (interface{}, error) {
+	topic, err := svc.findTopic(form.Get("TopicArn"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var attributes snsAttributes
+
+	for k, v := range topic.attributes {
+		attributes.Entry = append(attributes.Entry, snsAttributeEntry{Key: k, Value: v})
+	}
+
+	sort.Slice(attributes.Entry, func(i, j int) bool { return attributes.Entry[i].Key < attributes.Entry[j].Key })
+
+	return struct{ Attributes snsAttributes }{attributes}, nil
+}
+
+func (svc *snsService) listTopics(form url.Values, region string) (interface{}, error) {
+	type topicXML struct {
+		TopicArn string
+	}
+
+	var result struct {
+		Topics struct {
+			Member []topicXML `xml:"member"`
+		}
+	}
+
+	for topicARN := range svc.topics {
+		if strings.HasPrefix(topicARN, arn("sns", region, "")) {
+			result.Topics.Member = append(result.Topics.Member, topicXML{TopicArn: topicARN})
+		}
+	}
+
+	sort.Slice(result.Topics.Member, func(i, j int) bool { return result.Topics.Member[i].TopicArn < result.Topics.Member[j].TopicArn })
+
+	return result, nil
+}
+
+func (svc *snsService) setTopicAttributes(form url.Values, region string) (interface{}, error) {
+	topic, err := svc.findTopic(form.Get("TopicArn"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	switch name, value := form.Get("AttributeName"), form.Get("AttributeValue"); {
+	case name == "TopicArn" || name == "FifoTopic" || name == "Owner":
+		return nil, newQueryError(http.StatusBadRequest, "InvalidParameter", "Invalid parameter: AttributeName")
+	case name == "Policy" && value == "":
+		topic.attributes[name] = snsDefaultTopicPolicy(topic.arn)
+	case value == "" && name != "DisplayName":
+		delete(topic.attributes, name)
+	default:
+		topic.attributes[name] = value
+	}
+
+	return nil, nil
+}
+
+func (svc *snsService) listTagsForResource(form url.Values, region string) (interface{}, error) {
+	topic, err := svc.findTopic(form.Get("ResourceArn"))
+
+	if err != nil {
+		return nil, newQueryError(http.StatusNotFound, "ResourceNotFound", "Resource does not exist")
+	}
+
+	var result struct {
+		Tags struct {
+			Member []tag `xml:"member"`
+		}
+	}
+	result.Tags.Member = tagList(topic.tags)
+
+	return result, nil
+}
+
+func (svc *snsService) tagResource(form url.Values, region string) (interface{}, error) {
+	topic, err := svc.findTopic(form.Get("ResourceArn"))
+
+	if err != nil {
+		return nil, newQueryError(http.StatusNotFound, "ResourceNotFound", "Resource does not exist")
+	}
+
+	for k, v := range queryMap(form, "Tags.member", "Key", "Value") {
+		topic.tags[k] = v
+	}
+
+	return struct{}{}, nil
+}
+
+func (svc *snsService) untagResource(form url.Values, region string) (interface{}, error) {
+	topic, err := svc.findTopic(form.Get("ResourceArn"))
+
+	if err != nil {
+		return nil, newQueryError(http.StatusNotFound, "ResourceNotFound", "Resource does not exist")
+	}
+
+	for _, k := range queryList(form, "TagKeys.member") {
+		delete(topic.tags, k)
+	}
+
+	return struct{}{}, nil
+}
@@ -0,0 +1,265 @@
+package fakeaws
+
+import (
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const sqsXMLNamespace = "http://queue.amazonaws.com/doc/2012-11-05/"
+
+// sqsDefaultQueueAttributes are the attributes of a new queue which are not specified on creation.
+var sqsDefaultQueueAttributes = map[string]string{
+	"DelaySeconds":                  "0",
+	"MaximumMessageSize":            "262144",
+	"MessageRetentionPeriod":        "345600",
+	"ReceiveMessageWaitTimeSeconds": "0",
+	"VisibilityTimeout":             "30",
+}
+
+type sqsQueue struct {
+	name       string
+	region     string
+	url        string
+	attributes map[string]string
+	tags       map[string]string
+}
+
+type sqsService struct {
+	server *Server
+	queues map[string]*sqsQueue
+}
+
+func newSQSService(server *Server) *sqsService {
+	return &sqsService{
+		server: server,
+		queues: map[string]*sqsQueue{},
+	}
+}
+
+func (svc *sqsService) handle(w http.ResponseWriter, r *http.Request, region string) {
+	handleQuery(w, r, region, sqsXMLNamespace, map[string]queryAction{
+		"CreateQueue":        svc.createQueue,
+		"DeleteQueue":        svc.deleteQueue,
+		"GetQueueAttributes": svc.getQueueAttributes,
+		"GetQueueUrl":        svc.getQueueURL,
+		"ListQueueTags":      svc.listQueueTags,
+		"ListQueues":         svc.listQueues,
+		"SetQueueAttributes": svc.setQueueAttributes,
+		"TagQueue":           svc.tagQueue,
+		"UntagQueue":         svc.untagQueue,
+	})
+}
+
+type sqsAttribute struct {
+	Name  string
+	Value string
+}
+
+func (svc *sqsService) createQueue(form url.Values, region string) (interface{}, error) {
+	name := form.Get("QueueName")
+	attributes := queryMap(form, "Attribute", "Name", "Value")
+
+	if name == "" {
+		return nil, newQueryError(http.StatusBadRequest, "MissingParameter", "The request must contain the parameter QueueName.")
+	}
+
+	if fifo := attributes["FifoQueue"] == "true"; fifo != strings.HasSuffix(name, ".fifo") {
+		return nil, newQueryError(http.StatusBadRequest, "InvalidParameterValue", "The name of a FIFO queue can only include alphanumeric characters, hyphens, or underscores, must end with .fifo suffix and be 1 to 80 in length.")
+	}
+
+	for k, v := range sqsDefaultQueueAttributes {
+		if _, ok := attributes[k]; !ok {
+			attributes[k] = v
+		}
+	}
+
+	if queue, ok := svc.queues[name]; ok {
+		for k, v := range attributes {
+			if queue.attributes[k] != v {
+				return nil, newQueryError(http.StatusBadRequest, "QueueAlreadyExists", "A queue already exists with the same name and a different value for attribute %s", k)
+			}
+		}
+
+		return struct{ QueueUrl string }{queue.url}, nil
+	}
+
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+	attributes["CreatedTimestamp"] = now
+	attributes["LastModifiedTimestamp"] = now
+	attributes["QueueArn"] = arn("sqs", region, name)
+
+	queue := &sqsQueue{
+		name:       name,
+		region:     region,
+		url:        svc.server.URL + "/" + AccountID + "/" + name,
+		attributes: attributes,
+		tags:       queryMap(form, "Tag", "Key", "Value"),
+	}
+	svc.queues[name] = queue
+
+	return struct{ QueueUrl string }{queue.url}, nil
+}
+
+func (svc *sqsService) findQueue(form url.Values) (*sqsQueue, error) {
+	v := form.Get("QueueUrl")
+
+	if u, err := url.Parse(v); err == nil {
+		parts := strings.Split(u.Path, "/")
+
+		if queue, ok := svc.queues[parts[len(parts)-1]]; ok {
+			return queue, nil
+		}
+	}
+
+	return nil, newQueryError(http.StatusBadRequest, "AWS.SimpleQueueService.NonExistentQueue", "The specified queue does not exist for this wsdl version.")
+}
+
+func (svc *sqsService) deleteQueue(form url.Values, region string) (interface{}, error) {
+	queue, err := svc.findQueue(form)
+
+	if err != nil {
+		return nil, err
+	}
+
+	delete(svc.queues, queue.name)
+
+	return nil, nil
+}
+
+func (svc *sqsService) getQueueAttributes(form url.Values, region string) (interface{}, error) {
+	queue, err := svc.findQueue(form)
+
+	if err != nil {
+		return nil, err
+	}
+
+	names := queryList(form, "AttributeName")
+	all := len(names) == 0
+
+	for _, name := range names {
+		if name == "All" {
+			all = true
+		}
+	}
+
+	var result struct {
+		Attribute []sqsAttribute
+	}
+
+	for k, v := range queue.attributes {
+		if all || contains(names, k) {
+			result.Attribute = append(result.Attribute, sqsAttribute{Name: k, Value: v})
+		}
+	}
+
+	for _, k := range []string{"ApproximateNumberOfMessages", "ApproximateNumberOfMessagesDelayed", "ApproximateNumberOfMessagesNotVisible"} {
+		if all || contains(names, k) {
+			result.Attribute = append(result.Attribute, sqsAttribute{Name: k, Value: "0"})
+		}
+	}
+
+	sort.Slice(result.Attribute, func(i, j int) bool { return result.Attribute[i].Name < result.Attribute[j].Name })
+
+	return result, nil
+}
+
+func (svc *sqsService) getQueueURL(form url.Values, region string) (interface{}, error) {
+	queue, ok := svc.queues[form.Get("QueueName")]
+
+	if !ok {
+		return nil, newQueryError(http.StatusBadRequest, "AWS.SimpleQueueService.NonExistentQueue", "The specified queue does not exist for this wsdl version.")
+	}
+
+	return struct{ QueueUrl string }{queue.url}, nil
+}
+
+func (svc *sqsService) listQueues(form url.Values, region string) (interface{}, error) {
+	var result struct {
+		QueueUrl []string
+	}
+
+	for name, queue := range svc.queues {
+		if queue.region == region && strings.HasPrefix(name, form.Get("QueueNamePrefix")) {
+			result.QueueUrl = append(result.QueueUrl, queue.url)
+		}
+	}
+
+	sort.Strings(result.QueueUrl)
+
+	return result, nil
+}
+
+func (svc *sqsService) setQueueAttributes(form url.Values, region string) (interface{}, error) {
+	queue, err := svc.findQueue(form)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range queryMap(form, "Attribute", "Name", "Value") {
+		switch {
+		case k == "FifoQueue":
+			return nil, newQueryError(http.StatusBadRequest, "InvalidAttributeName", "Unknown Attribute %s.", k)
+		case v == "":
+			delete(queue.attributes, k)
+		default:
+			queue.attributes[k] = v
+		}
+	}
+
+	queue.attributes["LastModifiedTimestamp"] = strconv.FormatInt(time.Now().Unix(), 10)
+
+	return nil, nil
+}
+
+func (svc *sqsService) listQueueTags(form url.Values, region string) (interface{}, error) {
+	queue, err := svc.findQueue(form)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return struct{ Tag []tag }{tagList(queue.tags)}, nil
+}
+
+func (svc *sqsService) tagQueue(form url.Values, region string) (interface{}, error) {
+	queue, err := svc.findQueue(form)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range queryMap(form, "Tag", "Key", "Value") {
+		queue.tags[k] = v
+	}
+
+	return nil, nil
+}
+
+func (svc *sqsService) untagQueue(form url.Values, region string) (interface{}, error) {
+	queue, err := svc.findQueue(form)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, k := range queryList(form, "TagKey") {
+		delete(queue.tags, k)
+	}
+
+	return nil, nil
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
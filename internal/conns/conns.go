@@ -280,7 +280,8 @@ import (
 	"github.com/aws/aws-sdk-go/service/xray"
 	awsbase "github.com/hashicorp/aws-sdk-go-base"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
+	"github.com/nij4t/terraform-provider-aws/internal/create"
+	"github.com/nij4t/terraform-provider-aws/internal/logging"
 	tftags "github.com/nij4t/terraform-provider-aws/internal/tags"
 	"github.com/nij4t/terraform-provider-aws/version"
 )
@@ -846,13 +847,15 @@ func init() {
 }
 
 type Config struct {
-	AccessKey     string
-	SecretKey     string
-	CredsFilename string
-	Profile       string
-	Token         string
-	Region        string
-	MaxRetries    int
+	AccessKey              string
+	SecretKey              string
+	CredsFilename          string
+	SharedConfigFiles      []string
+	SharedCredentialsFiles []string
+	Profile                string
+	Token                  string
+	Region                 string
+	MaxRetries             int
 
 	AssumeRoleARN               string
 	AssumeRoleDurationSeconds   int
@@ -866,11 +869,19 @@ type Config struct {
 	AllowedAccountIds   []string
 	ForbiddenAccountIds []string
 
-	DefaultTagsConfig *tftags.DefaultConfig
-	Endpoints         map[string]string
-	IgnoreTagsConfig  *tftags.IgnoreConfig
-	Insecure          bool
-	HTTPProxy         string
+	CustomCABundle                 string
+	DefaultTagsConfig              *tftags.DefaultConfig
+	EC2MetadataServiceEndpoint     string
+	EC2MetadataServiceEndpointMode string
+	Endpoints                      map[string]string
+	IgnoreTagsConfig               *tftags.IgnoreConfig
+	Insecure                       bool
+	HTTPProxy                      string
+	NamingPolicy                   *create.NamingPolicy
+	PolicyValidation               bool
+	QuotaChecks                    bool
+	UseDualStackEndpoint           bool
+	UseFIPSEndpoint                bool
 
 	SkipCredsValidation     bool
 	SkipGetEC2Platforms     bool
@@ -1069,6 +1080,7 @@ type AWSClient struct {
 	MQConn                            *mq.MQ
 	MTurkConn                         *mturk.MTurk
 	MWAAConn                          *mwaa.MWAA
+	NamingPolicy                      *create.NamingPolicy
 	NeptuneConn                       *neptune.Neptune
 	NetworkFirewallConn               *networkfirewall.NetworkFirewall
 	NetworkManagerConn                *networkmanager.NetworkManager
@@ -1078,6 +1090,7 @@ type AWSClient struct {
 	OrganizationsConn                 *organizations.Organizations
 	OutpostsConn                      *outposts.Outposts
 	Partition                         string
+	PolicyValidation                  bool
 	PersonalizeConn                   *personalize.Personalize
 	PersonalizeEventsConn             *personalizeevents.PersonalizeEvents
 	PersonalizeRuntimeConn            *personalizeruntime.PersonalizeRuntime
@@ -1091,6 +1104,7 @@ type AWSClient struct {
 	QLDBConn                          *qldb.QLDB
 	QLDBSessionConn                   *qldbsession.QLDBSession
 	QuickSightConn                    *quicksight.QuickSight
+	QuotaChecks                       bool
 	RAMConn                           *ram.RAM
 	RDSConn                           *rds.RDS
 	RDSDataConn                       *rdsdataservice.RDSDataService
@@ -1162,21 +1176,100 @@ type AWSClient struct {
 	WorkMailConn                      *workmail.WorkMail
 	WorkMailMessageFlowConn           *workmailmessageflow.WorkMailMessageFlow
 	WorkSpacesConn                    *workspaces.WorkSpaces
+	UseDualStackEndpoint              bool
+	UseFIPSEndpoint                   bool
 	XRayConn                          *xray.XRay
+
+	config          Config
+	regionalClients *regionalClients
 }
 
 // PartitionHostname returns a hostname with the provider domain suffix for the partition
 // e.g. PREFIX.amazonaws.com
 // The prefix should not contain a trailing period.
+// FIPS and dual-stack hostnames are returned if so configured and the service's partition endpoint has such a variant.
 func (client *AWSClient) PartitionHostname(prefix string) string {
-	return fmt.Sprintf("%s.%s", prefix, client.DNSSuffix)
+	hostname := fmt.Sprintf("%s.%s", prefix, client.DNSSuffix)
+
+	return client.variantHostname(prefix, fmt.Sprintf("%s-global", client.Partition), hostname)
 }
 
 // RegionalHostname returns a hostname with the provider domain suffix for the region and partition
 // e.g. PREFIX.us-west-2.amazonaws.com
 // The prefix should not contain a trailing period.
+// FIPS and dual-stack hostnames are returned if so configured and the service's regional endpoint has such a variant,
+// e.g. BUCKET.s3-fips.us-west-2.amazonaws.com or BUCKET.s3.dualstack.us-west-2.amazonaws.com.
 func (client *AWSClient) RegionalHostname(prefix string) string {
-	return fmt.Sprintf("%s.%s.%s", prefix, client.Region, client.DNSSuffix)
+	hostname := fmt.Sprintf("%s.%s.%s", prefix, client.Region, client.DNSSuffix)
+
+	return client.variantHostname(prefix, client.Region, hostname)
+}
+
+// variantHostname returns the configured FIPS and/or dual-stack variant of a hostname.
+// The last label of the prefix is the service's endpoint prefix, e.g. "s3" in "BUCKET.s3",
+// and the variant is resolved by the SDK's endpoint resolver for that service in the specified region.
+// The hostname is returned unchanged if no variant is configured, or if the resolver has no such variant
+// or doesn't resolve the service's standard endpoint to the hostname's suffix.
+func (client *AWSClient) variantHostname(prefix, region, hostname string) string {
+	if !client.UseFIPSEndpoint && !client.UseDualStackEndpoint {
+		return hostname
+	}
+
+	var partition *endpoints.Partition
+
+	for _, p := range endpoints.DefaultPartitions() {
+		if p.ID() == client.Partition {
+			partition = &p
+			break
+		}
+	}
+
+	if partition == nil {
+		return hostname
+	}
+
+	service, labels := prefix, ""
+
+	if i := strings.LastIndex(prefix, "."); i >= 0 {
+		service, labels = prefix[i+1:], prefix[:i+1]
+	}
+
+	standard, err := partition.EndpointFor(service, region, func(o *endpoints.Options) {
+		o.StrictMatching = true
+	})
+
+	if err != nil || endpointHostname(standard) != strings.TrimPrefix(hostname, labels) {
+		return hostname
+	}
+
+	variant, err := partition.EndpointFor(service, region, func(o *endpoints.Options) {
+		o.StrictMatching = true
+
+		if client.UseFIPSEndpoint {
+			o.UseFIPSEndpoint = endpoints.FIPSEndpointStateEnabled
+		}
+
+		if client.UseDualStackEndpoint {
+			o.UseDualStackEndpoint = endpoints.DualStackEndpointStateEnabled
+		}
+	})
+
+	if err != nil {
+		return hostname
+	}
+
+	return labels + endpointHostname(variant)
+}
+
+// endpointHostname returns the hostname of a resolved endpoint's URL.
+func endpointHostname(endpoint endpoints.ResolvedEndpoint) string {
+	hostname := endpoint.URL
+
+	if i := strings.Index(hostname, "://"); i >= 0 {
+		hostname = hostname[i+3:]
+	}
+
+	return strings.TrimSuffix(hostname, "/")
 }
 
 // Client configures and returns a fully initialized AWSClient
@@ -1202,7 +1295,6 @@ func (c *Config) Client() (interface{}, error) {
 		CallerDocumentationURL:      "https://registry.terraform.io/providers/hashicorp/aws",
 		CallerName:                  "Terraform AWS Provider",
 		CredsFilename:               c.CredsFilename,
-		DebugLogging:                logging.IsDebugOrHigher(),
 		IamEndpoint:                 c.Endpoints[IAM],
 		Insecure:                    c.Insecure,
 		HTTPProxy:                   c.HTTPProxy,
@@ -1218,7 +1310,7 @@ func (c *Config) Client() (interface{}, error) {
 		UserAgentProducts:           StdUserAgentProducts(c.TerraformVersion),
 	}
 
-	sess, accountID, Partition, err := awsbase.GetSessionWithAccountIDAndPartition(awsbaseConfig)
+	sess, accountID, Partition, err := c.newSession(awsbaseConfig)
 	if err != nil {
 		return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
 	}
@@ -1421,6 +1513,7 @@ func (c *Config) Client() (interface{}, error) {
 		MQConn:                            mq.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MQ])})),
 		MTurkConn:                         mturk.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MTurk])})),
 		MWAAConn:                          mwaa.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MWAA])})),
+		NamingPolicy:                      c.NamingPolicy,
 		NeptuneConn:                       neptune.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Neptune])})),
 		NetworkFirewallConn:               networkfirewall.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[NetworkFirewall])})),
 		NetworkManagerConn:                networkmanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[NetworkManager])})),
@@ -1430,6 +1523,7 @@ func (c *Config) Client() (interface{}, error) {
 		OrganizationsConn:                 organizations.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Organizations])})),
 		OutpostsConn:                      outposts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Outposts])})),
 		Partition:                         Partition,
+		PolicyValidation:                  c.PolicyValidation,
 		PersonalizeConn:                   personalize.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Personalize])})),
 		PersonalizeEventsConn:             personalizeevents.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[PersonalizeEvents])})),
 		PersonalizeRuntimeConn:            personalizeruntime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[PersonalizeRuntime])})),
@@ -1443,6 +1537,7 @@ func (c *Config) Client() (interface{}, error) {
 		QLDBConn:                          qldb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[QLDB])})),
 		QLDBSessionConn:                   qldbsession.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[QLDBSession])})),
 		QuickSightConn:                    quicksight.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[QuickSight])})),
+		QuotaChecks:                       c.QuotaChecks,
 		RAMConn:                           ram.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[RAM])})),
 		RDSConn:                           rds.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[RDS])})),
 		RDSDataConn:                       rdsdataservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[RDSData])})),
@@ -1509,6 +1604,8 @@ func (c *Config) Client() (interface{}, error) {
 		WorkMailConn:                      workmail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[WorkMail])})),
 		WorkMailMessageFlowConn:           workmailmessageflow.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[WorkMailMessageFlow])})),
 		WorkSpacesConn:                    workspaces.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[WorkSpaces])})),
+		UseDualStackEndpoint:              c.UseDualStackEndpoint,
+		UseFIPSEndpoint:                   c.UseFIPSEndpoint,
 		XRayConn:                          xray.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[XRay])})),
 	}
 
@@ -1798,6 +1895,13 @@ func (c *Config) Client() (interface{}, error) {
 		}
 	})
 
+	client.config = *c
+	client.regionalClients = &regionalClients{
+		clients: map[string]*AWSClient{
+			c.Region: client,
+		},
+	}
+
 	if !c.SkipGetEC2Platforms {
 		supportedPlatforms, err := GetSupportedEC2Platforms(client.EC2Conn)
 		if err != nil {
@@ -1835,6 +1939,8 @@ func NewSessionForRegion(cfg *aws.Config, region, terraformVersion string) (*ses
 		session.Handlers.Build.PushFront(request.MakeAddToUserAgentHandler(product.Name, product.Version, product.Extra...))
 	}
 
+	logging.AddRequestHandlers(&session.Handlers)
+
 	return session.Copy(&aws.Config{Region: aws.String(region)}), nil
 }
 
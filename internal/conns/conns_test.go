@@ -31,6 +31,26 @@ func TestAWSClientPartitionHostname(t *testing.T) {
 			Prefix:   "test",
 			Expected: "test.amazonaws.com.cn",
 		},
+		{
+			Name: "AWS Commercial FIPS unknown service",
+			AWSClient: &AWSClient{
+				DNSSuffix:       "amazonaws.com",
+				Partition:       "aws",
+				UseFIPSEndpoint: true,
+			},
+			Prefix:   "test",
+			Expected: "test.amazonaws.com",
+		},
+		{
+			Name: "AWS Commercial FIPS",
+			AWSClient: &AWSClient{
+				DNSSuffix:       "amazonaws.com",
+				Partition:       "aws",
+				UseFIPSEndpoint: true,
+			},
+			Prefix:   "iam",
+			Expected: "iam-fips.amazonaws.com",
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -69,6 +89,62 @@ func TestAWSClientRegionalHostname(t *testing.T) {
 			Prefix:   "test",
 			Expected: "test.cn-northwest-1.amazonaws.com.cn", //lintignore:AWSAT003
 		},
+		{
+			Name: "AWS Commercial FIPS",
+			AWSClient: &AWSClient{
+				DNSSuffix:       "amazonaws.com",
+				Partition:       "aws",
+				Region:          "us-west-2", //lintignore:AWSAT003
+				UseFIPSEndpoint: true,
+			},
+			Prefix:   "bucket.s3",
+			Expected: "bucket.s3-fips.us-west-2.amazonaws.com", //lintignore:AWSAT003
+		},
+		{
+			Name: "AWS Commercial dual-stack",
+			AWSClient: &AWSClient{
+				DNSSuffix:            "amazonaws.com",
+				Partition:            "aws",
+				Region:               "us-west-2", //lintignore:AWSAT003
+				UseDualStackEndpoint: true,
+			},
+			Prefix:   "bucket.s3",
+			Expected: "bucket.s3.dualstack.us-west-2.amazonaws.com", //lintignore:AWSAT003
+		},
+		{
+			Name: "AWS Commercial FIPS and dual-stack",
+			AWSClient: &AWSClient{
+				DNSSuffix:            "amazonaws.com",
+				Partition:            "aws",
+				Region:               "us-west-2", //lintignore:AWSAT003
+				UseDualStackEndpoint: true,
+				UseFIPSEndpoint:      true,
+			},
+			Prefix:   "bucket.s3",
+			Expected: "bucket.s3-fips.dualstack.us-west-2.amazonaws.com", //lintignore:AWSAT003
+		},
+		{
+			Name: "AWS Commercial FIPS without variant",
+			AWSClient: &AWSClient{
+				DNSSuffix:       "amazonaws.com",
+				Partition:       "aws",
+				Region:          "us-west-2", //lintignore:AWSAT003
+				UseFIPSEndpoint: true,
+			},
+			Prefix:   "bucket.s3-website",
+			Expected: "bucket.s3-website.us-west-2.amazonaws.com", //lintignore:AWSAT003
+		},
+		{
+			Name: "AWS Commercial dual-stack without variant",
+			AWSClient: &AWSClient{
+				DNSSuffix:            "amazonaws.com",
+				Partition:            "aws",
+				Region:               "us-west-2", //lintignore:AWSAT003
+				UseDualStackEndpoint: true,
+			},
+			Prefix:   "fs-12345678.efs",
+			Expected: "fs-12345678.efs.us-west-2.amazonaws.com", //lintignore:AWSAT003
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -0,0 +1,58 @@
+package conns
+
+import (
+	"fmt"
+	"sync"
+)
+
+// regionalClients caches the AWSClients derived from a provider configuration
+// for regions other than the provider's own.
+type regionalClients struct {
+	mu      sync.Mutex
+	clients map[string]*AWSClient
+}
+
+// RegionalClient returns an AWSClient for the specified region.
+// The client is configured with the same credentials and settings as this client,
+// except for custom service endpoints, which are specific to this client's region,
+// and is created on first use and then cached.
+// An empty region or this client's own region returns this client.
+func (client *AWSClient) RegionalClient(region string) (*AWSClient, error) {
+	if region == "" || region == client.Region {
+		return client, nil
+	}
+
+	if client.regionalClients == nil {
+		return nil, fmt.Errorf("AWS client for region (%s) cannot be configured for region (%s)", client.Region, region)
+	}
+
+	client.regionalClients.mu.Lock()
+	defer client.regionalClients.mu.Unlock()
+
+	if v, ok := client.regionalClients.clients[region]; ok {
+		return v, nil
+	}
+
+	config := client.config
+	config.Region = region
+	config.Endpoints = nil
+	// The credentials and account have already been validated when configuring the provider.
+	config.AllowedAccountIds = nil
+	config.ForbiddenAccountIds = nil
+	config.SkipCredsValidation = true
+	config.SkipRequestingAccountId = true
+
+	raw, err := config.Client()
+
+	if err != nil {
+		return nil, fmt.Errorf("error configuring AWS client for region (%s): %w", region, err)
+	}
+
+	v := raw.(*AWSClient)
+	v.AccountID = client.AccountID
+	v.regionalClients = client.regionalClients
+
+	client.regionalClients.clients[region] = v
+
+	return v, nil
+}
@@ -0,0 +1,70 @@
+package conns
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+func TestAWSClientRegionalClient(t *testing.T) {
+	config := &Config{
+		AccessKey:               "mock_access_key",
+		Region:                  "us-west-2", //lintignore:AWSAT003
+		SecretKey:               "mock_secret_key",
+		SkipCredsValidation:     true,
+		SkipGetEC2Platforms:     true,
+		SkipMetadataApiCheck:    true,
+		SkipRequestingAccountId: true,
+	}
+
+	raw, err := config.Client()
+
+	if err != nil {
+		t.Fatalf("error configuring client: %s", err)
+	}
+
+	client := raw.(*AWSClient)
+	client.AccountID = "123456789012"
+
+	for _, region := range []string{"", "us-west-2"} { //lintignore:AWSAT003
+		got, err := client.RegionalClient(region)
+
+		if err != nil {
+			t.Fatalf("error getting client for region (%s): %s", region, err)
+		}
+
+		if got != client {
+			t.Errorf("expected provider client for region (%s)", region)
+		}
+	}
+
+	other, err := client.RegionalClient("eu-west-1") //lintignore:AWSAT003
+
+	if err != nil {
+		t.Fatalf("error getting regional client: %s", err)
+	}
+
+	if got, expected := other.Region, "eu-west-1"; got != expected { //lintignore:AWSAT003
+		t.Errorf("got region %s, expected %s", got, expected)
+	}
+
+	if got, expected := aws.StringValue(other.EC2Conn.Config.Region), "eu-west-1"; got != expected { //lintignore:AWSAT003
+		t.Errorf("got EC2 client region %s, expected %s", got, expected)
+	}
+
+	if got, expected := other.AccountID, client.AccountID; got != expected {
+		t.Errorf("got account ID %s, expected %s", got, expected)
+	}
+
+	if got, err := client.RegionalClient("eu-west-1"); err != nil || got != other { //lintignore:AWSAT003
+		t.Errorf("expected cached client for region (eu-west-1), got error: %v", err)
+	}
+
+	if got, err := other.RegionalClient("us-west-2"); err != nil || got != client { //lintignore:AWSAT003
+		t.Errorf("expected provider client for region (us-west-2), got error: %v", err)
+	}
+
+	if _, err := (&AWSClient{Region: "us-west-2"}).RegionalClient("eu-west-1"); err == nil { //lintignore:AWSAT003
+		t.Errorf("expected error for unconfigured client")
+	}
+}
@@ -0,0 +1,412 @@
+package conns
+
+import (
+	"bytes"
+	"crypto/tls"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	awscredentials "github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/defaults"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/sts"
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/mitchellh/go-homedir"
+	"github.com/nij4t/terraform-provider-aws/internal/logging"
+)
+
+const (
+	EC2MetadataServiceEndpointModeIPv4 = "IPv4"
+	EC2MetadataServiceEndpointModeIPv6 = "IPv6"
+)
+
+func EC2MetadataServiceEndpointMode_Values() []string {
+	return []string{
+		EC2MetadataServiceEndpointModeIPv4,
+		EC2MetadataServiceEndpointModeIPv6,
+	}
+}
+
+// newSession returns an AWS SDK for Go session along with the account ID and partition of its credentials.
+// It is adapted from github.com/hashicorp/aws-sdk-go-base@v1.0.0/session.go, extended with
+// FIPS and dual-stack endpoints, a custom CA bundle, EC2 Instance Metadata Service (IMDS) endpoint settings
+// and multiple shared configuration and credentials files.
+func (c *Config) newSession(awsbaseConfig *awsbase.Config) (*session.Session, string, string, error) {
+	if c.SkipMetadataApiCheck {
+		os.Setenv("AWS_EC2_METADATA_DISABLED", "true")
+	}
+
+	options, err := c.sessionOptions(awsbaseConfig, true)
+
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	creds, err := c.credentials(awsbaseConfig)
+
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	options.Config.Credentials = creds
+
+	sess, err := session.NewSessionWithOptions(*options)
+
+	if err != nil {
+		if tfawserr.ErrCodeEquals(err, "NoCredentialProviders") {
+			return nil, "", "", awsbaseConfig.NewNoValidCredentialSourcesError(err)
+		}
+
+		return nil, "", "", fmt.Errorf("Error creating AWS session: %w", err)
+	}
+
+	logging.AddRequestHandlers(&sess.Handlers)
+
+	if c.MaxRetries > 0 {
+		sess = sess.Copy(&aws.Config{MaxRetries: aws.Int(c.MaxRetries)})
+	}
+
+	// See github.com/hashicorp/aws-sdk-go-base@v1.0.0/session.go for the order of the User-Agent products.
+	for i := len(awsbaseConfig.UserAgentProducts) - 1; i >= 0; i-- {
+		product := awsbaseConfig.UserAgentProducts[i]
+		sess.Handlers.Build.PushFront(request.MakeAddToUserAgentHandler(product.Name, product.Version, product.Extra...))
+	}
+
+	if v := os.Getenv(awsbase.AppendUserAgentEnvVar); v != "" {
+		log.Printf("[DEBUG] Using additional User-Agent Info: %s", v)
+		sess.Handlers.Build.PushBack(request.MakeAddToUserAgentFreeFormHandler(v))
+	}
+
+	// Limit retries of networking errors such as non-existent service endpoints,
+	// which become more likely with FIPS and dual-stack endpoints.
+	sess.Handlers.Retry.PushBack(func(r *request.Request) {
+		if r.RetryCount < awsbase.MaxNetworkRetryCount {
+			return
+		}
+
+		if tfawserr.ErrMessageAndOrigErrContain(r.Error, request.ErrCodeRequestError, "send request failed", "no such host") {
+			log.Printf("[WARN] Disabling retries after next request due to networking issue")
+			r.Retryable = aws.Bool(false)
+		}
+
+		if tfawserr.ErrMessageAndOrigErrContain(r.Error, request.ErrCodeRequestError, "send request failed", "connection refused") {
+			log.Printf("[WARN] Disabling retries after next request due to networking issue")
+			r.Retryable = aws.Bool(false)
+		}
+	})
+
+	stsClient := sts.New(sess)
+
+	if !c.SkipCredsValidation {
+		accountID, partition, err := awsbase.GetAccountIDAndPartitionFromSTSGetCallerIdentity(stsClient)
+
+		if err != nil {
+			return nil, "", "", fmt.Errorf("error validating provider credentials: %w", err)
+		}
+
+		return sess, accountID, partition, nil
+	}
+
+	if c.AssumeRoleARN != "" {
+		if v, err := arn.Parse(c.AssumeRoleARN); err == nil {
+			return sess, v.AccountID, v.Partition, nil
+		}
+	}
+
+	if !c.SkipRequestingAccountId {
+		credentialsProviderName := ""
+
+		if credentialsValue, err := sess.Config.Credentials.Get(); err == nil {
+			credentialsProviderName = credentialsValue.ProviderName
+		}
+
+		accountID, partition, err := awsbase.GetAccountIDAndPartition(iam.New(sess), stsClient, credentialsProviderName)
+
+		if err != nil {
+			return nil, "", "", fmt.Errorf(
+				"AWS account ID not previously found and failed retrieving via all available methods. "+
+					"See https://www.terraform.io/docs/providers/aws/index.html#skip_requesting_account_id for workaround and implications. "+
+					"Errors: %w", err)
+		}
+
+		return sess, accountID, partition, nil
+	}
+
+	var partition string
+
+	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), c.Region); ok {
+		partition = p.ID()
+	}
+
+	return sess, "", partition, nil
+}
+
+// sessionOptions returns the options common to all of the provider's AWS SDK for Go sessions.
+// A new reader is returned for any custom CA bundle, as each session consumes it.
+func (c *Config) sessionOptions(awsbaseConfig *awsbase.Config, withHTTPClient bool) (*session.Options, error) {
+	options := &session.Options{
+		Config: aws.Config{
+			CredentialsChainVerboseErrors: aws.Bool(true),
+			EndpointResolver:              awsbaseConfig.EndpointResolver(),
+			MaxRetries:                    aws.Int(0),
+			Region:                        aws.String(c.Region),
+		},
+		EC2IMDSEndpoint:   c.EC2MetadataServiceEndpoint,
+		Profile:           c.Profile,
+		SharedConfigState: session.SharedConfigEnable,
+	}
+
+	if c.UseDualStackEndpoint {
+		options.Config.UseDualStackEndpoint = endpoints.DualStackEndpointStateEnabled
+	}
+
+	if c.UseFIPSEndpoint {
+		options.Config.UseFIPSEndpoint = endpoints.FIPSEndpointStateEnabled
+	}
+
+	if v := c.EC2MetadataServiceEndpointMode; v != "" {
+		if err := options.EC2IMDSEndpointMode.SetFromString(v); err != nil {
+			return nil, fmt.Errorf("error parsing EC2 metadata service endpoint mode: %w", err)
+		}
+	}
+
+	// Shared configuration is loaded from the shared configuration files followed by the shared credentials files,
+	// so that credentials files take precedence, defaulting either list if only the other is configured.
+	// The order is copied from github.com/hashicorp/aws-sdk-go-base@v1.0.0/session.go.
+	if credentialsFiles, configFiles := c.sharedCredentialsFiles(), c.SharedConfigFiles; len(credentialsFiles) > 0 || len(configFiles) > 0 {
+		if len(credentialsFiles) == 0 {
+			credentialsFiles = []string{defaultSharedFilename("AWS_SHARED_CREDENTIALS_FILE", defaults.SharedCredentialsFilename())}
+		}
+
+		if len(configFiles) == 0 {
+			configFiles = []string{defaultSharedFilename("AWS_CONFIG_FILE", defaults.SharedConfigFilename())}
+		}
+
+		for _, file := range append(append([]string{}, configFiles...), credentialsFiles...) {
+			v, err := homedir.Expand(file)
+
+			if err != nil {
+				return nil, fmt.Errorf("error expanding shared configuration filename (%s): %w", file, err)
+			}
+
+			options.SharedConfigFiles = append(options.SharedConfigFiles, v)
+		}
+	}
+
+	if v := c.CustomCABundle; v != "" {
+		filename, err := homedir.Expand(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("error expanding custom CA bundle filename: %w", err)
+		}
+
+		bundle, err := os.ReadFile(filename)
+
+		if err != nil {
+			return nil, fmt.Errorf("error reading custom CA bundle (%s): %w", filename, err)
+		}
+
+		options.CustomCABundle = bytes.NewReader(bundle)
+		withHTTPClient = true
+	}
+
+	// Avoid setting HTTPClient for session-derived credentials as it will prevent the ec2metadata
+	// client from automatically lowering the timeout to 1 second.
+	if !withHTTPClient {
+		return options, nil
+	}
+
+	options.Config.HTTPClient = cleanhttp.DefaultClient()
+	transport := options.Config.HTTPClient.Transport.(*http.Transport)
+
+	if c.Insecure {
+		transport.TLSClientConfig = &tls.Config{
+			InsecureSkipVerify: true,
+		}
+	}
+
+	if c.HTTPProxy != "" {
+		proxyUrl, err := url.Parse(c.HTTPProxy)
+
+		if err != nil {
+			return nil, fmt.Errorf("error parsing HTTP proxy URL: %w", err)
+		}
+
+		transport.Proxy = http.ProxyURL(proxyUrl)
+	}
+
+	return options, nil
+}
+
+// sharedCredentialsFiles returns the configured shared credentials files in the order in which they are searched.
+func (c *Config) sharedCredentialsFiles() []string {
+	if c.CredsFilename == "" {
+		return c.SharedCredentialsFiles
+	}
+
+	return append([]string{c.CredsFilename}, c.SharedCredentialsFiles...)
+}
+
+// defaultSharedFilename returns the shared configuration or credentials filename from the environment, if set,
+// or the SDK default.
+func defaultSharedFilename(envVar, filename string) string {
+	if v := os.Getenv(envVar); v != "" {
+		return v
+	}
+
+	return filename
+}
+
+// credentials returns validated credentials from, in order, the provider configuration, the environment,
+// the shared credentials files or a session (which may use a credential process or the ECS or EC2 metadata endpoints).
+// If a role is configured, the credentials are those of the assumed role.
+// It is adapted from github.com/hashicorp/aws-sdk-go-base@v1.0.0/awsauth.go.
+func (c *Config) credentials(awsbaseConfig *awsbase.Config) (*awscredentials.Credentials, error) {
+	providers := []awscredentials.Provider{
+		&awscredentials.StaticProvider{Value: awscredentials.Value{
+			AccessKeyID:     c.AccessKey,
+			SecretAccessKey: c.SecretKey,
+			SessionToken:    c.Token,
+		}},
+		&awscredentials.EnvProvider{},
+	}
+
+	files := c.sharedCredentialsFiles()
+
+	if len(files) == 0 {
+		// The default shared credentials file.
+		files = []string{""}
+	}
+
+	for _, file := range files {
+		filename, err := homedir.Expand(file)
+
+		if err != nil {
+			return nil, fmt.Errorf("error expanding shared credentials filename (%s): %w", file, err)
+		}
+
+		providers = append(providers, &awscredentials.SharedCredentialsProvider{
+			Filename: filename,
+			Profile:  c.Profile,
+		})
+	}
+
+	creds := awscredentials.NewChainCredentials(providers)
+
+	if cp, err := creds.Get(); err != nil {
+		if !tfawserr.ErrCodeEquals(err, "NoCredentialProviders") {
+			return nil, fmt.Errorf("Error loading credentials for AWS Provider: %w", err)
+		}
+
+		log.Printf("[INFO] Attempting to use session-derived credentials")
+
+		options, err := c.sessionOptions(awsbaseConfig, false)
+
+		if err != nil {
+			return nil, err
+		}
+
+		sess, err := session.NewSessionWithOptions(*options)
+
+		if err != nil {
+			if tfawserr.ErrCodeEquals(err, "NoCredentialProviders") {
+				return nil, awsbaseConfig.NewNoValidCredentialSourcesError(err)
+			}
+
+			return nil, fmt.Errorf("Error creating AWS session: %w", err)
+		}
+
+		creds = sess.Config.Credentials
+
+		if cp, err = creds.Get(); err != nil {
+			return nil, awsbaseConfig.NewNoValidCredentialSourcesError(err)
+		}
+
+		log.Printf("[INFO] Successfully derived credentials from session")
+		log.Printf("[INFO] AWS Auth provider used: %q", cp.ProviderName)
+	} else {
+		log.Printf("[INFO] AWS Auth provider used: %q", cp.ProviderName)
+	}
+
+	if c.AssumeRoleARN == "" {
+		return creds, nil
+	}
+
+	log.Printf("[INFO] Attempting to AssumeRole %s (SessionName: %q, ExternalId: %q)",
+		c.AssumeRoleARN, c.AssumeRoleSessionName, c.AssumeRoleExternalID)
+
+	options, err := c.sessionOptions(awsbaseConfig, true)
+
+	if err != nil {
+		return nil, err
+	}
+
+	options.Config.Credentials = creds
+	options.Config.MaxRetries = aws.Int(c.MaxRetries)
+
+	sess, err := session.NewSessionWithOptions(*options)
+
+	if err != nil {
+		return nil, fmt.Errorf("error creating assume role session: %w", err)
+	}
+
+	logging.AddRequestHandlers(&sess.Handlers)
+
+	assumeRoleProvider := &stscreds.AssumeRoleProvider{
+		Client:  sts.New(sess),
+		RoleARN: c.AssumeRoleARN,
+	}
+
+	if c.AssumeRoleDurationSeconds > 0 {
+		assumeRoleProvider.Duration = time.Duration(c.AssumeRoleDurationSeconds) * time.Second
+	}
+
+	if c.AssumeRoleExternalID != "" {
+		assumeRoleProvider.ExternalID = aws.String(c.AssumeRoleExternalID)
+	}
+
+	if c.AssumeRolePolicy != "" {
+		assumeRoleProvider.Policy = aws.String(c.AssumeRolePolicy)
+	}
+
+	for _, policyARN := range c.AssumeRolePolicyARNs {
+		assumeRoleProvider.PolicyArns = append(assumeRoleProvider.PolicyArns, &sts.PolicyDescriptorType{
+			Arn: aws.String(policyARN),
+		})
+	}
+
+	if c.AssumeRoleSessionName != "" {
+		assumeRoleProvider.RoleSessionName = c.AssumeRoleSessionName
+	}
+
+	for k, v := range c.AssumeRoleTags {
+		assumeRoleProvider.Tags = append(assumeRoleProvider.Tags, &sts.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+
+	if len(c.AssumeRoleTransitiveTagKeys) > 0 {
+		assumeRoleProvider.TransitiveTagKeys = aws.StringSlice(c.AssumeRoleTransitiveTagKeys)
+	}
+
+	assumeRoleCreds := awscredentials.NewChainCredentials([]awscredentials.Provider{assumeRoleProvider})
+
+	if _, err := assumeRoleCreds.Get(); err != nil {
+		return nil, awsbaseConfig.NewCannotAssumeRoleError(err)
+	}
+
+	return assumeRoleCreds, nil
+}
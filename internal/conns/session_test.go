@@ -0,0 +1,116 @@
+package conns
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+)
+
+func TestConfigSessionOptions(t *testing.T) {
+	dir := t.TempDir()
+	caBundle := filepath.Join(dir, "ca-bundle.pem")
+
+	if err := os.WriteFile(caBundle, []byte("-----BEGIN CERTIFICATE-----\n-----END CERTIFICATE-----\n"), 0600); err != nil {
+		t.Fatalf("error writing CA bundle: %s", err)
+	}
+
+	config := &Config{
+		CustomCABundle:                 caBundle,
+		EC2MetadataServiceEndpoint:     "http://[fd00:ec2::254]",
+		EC2MetadataServiceEndpointMode: EC2MetadataServiceEndpointModeIPv6,
+		Region:                         "us-west-2", //lintignore:AWSAT003
+		SharedConfigFiles:              []string{"/config1", "/config2"},
+		SharedCredentialsFiles:         []string{"/credentials1", "/credentials2"},
+		UseDualStackEndpoint:           true,
+		UseFIPSEndpoint:                true,
+	}
+
+	options, err := config.sessionOptions(&awsbase.Config{}, false)
+
+	if err != nil {
+		t.Fatalf("error getting session options: %s", err)
+	}
+
+	if got, expected := aws.StringValue(options.Config.Region), "us-west-2"; got != expected { //lintignore:AWSAT003
+		t.Errorf("got Region %s, expected %s", got, expected)
+	}
+
+	if got, expected := options.Config.UseFIPSEndpoint, endpoints.FIPSEndpointStateEnabled; got != expected {
+		t.Errorf("got UseFIPSEndpoint %d, expected %d", got, expected)
+	}
+
+	if got, expected := options.Config.UseDualStackEndpoint, endpoints.DualStackEndpointStateEnabled; got != expected {
+		t.Errorf("got UseDualStackEndpoint %d, expected %d", got, expected)
+	}
+
+	if got, expected := options.EC2IMDSEndpoint, "http://[fd00:ec2::254]"; got != expected {
+		t.Errorf("got EC2IMDSEndpoint %s, expected %s", got, expected)
+	}
+
+	if got, expected := options.EC2IMDSEndpointMode, endpoints.EC2IMDSEndpointModeStateIPv6; got != expected {
+		t.Errorf("got EC2IMDSEndpointMode %d, expected %d", got, expected)
+	}
+
+	if got, expected := options.SharedConfigFiles, []string{"/config1", "/config2", "/credentials1", "/credentials2"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("got SharedConfigFiles %v, expected %v", got, expected)
+	}
+
+	if options.CustomCABundle == nil {
+		t.Error("expected CustomCABundle")
+	}
+
+	if options.Config.HTTPClient == nil {
+		t.Error("expected HTTPClient for CustomCABundle")
+	}
+}
+
+func TestConfigSessionOptions_invalidEC2MetadataServiceEndpointMode(t *testing.T) {
+	config := &Config{
+		EC2MetadataServiceEndpointMode: "IPv5",
+		Region:                         "us-west-2", //lintignore:AWSAT003
+	}
+
+	if _, err := config.sessionOptions(&awsbase.Config{}, false); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestConfigSharedCredentialsFiles(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Config   *Config
+		Expected []string
+	}{
+		{
+			Name:   "none",
+			Config: &Config{},
+		},
+		{
+			Name: "shared_credentials_file",
+			Config: &Config{
+				CredsFilename: "/credentials",
+			},
+			Expected: []string{"/credentials"},
+		},
+		{
+			Name: "shared_credentials_files",
+			Config: &Config{
+				SharedCredentialsFiles: []string{"/credentials1", "/credentials2"},
+			},
+			Expected: []string{"/credentials1", "/credentials2"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := testCase.Config.sharedCredentialsFiles(); !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
@@ -9,22 +9,21 @@ import (
 )
 
 // Name returns in order the name if non-empty, a prefix generated name if non-empty, or fully generated name prefixed with terraform-
-func Name(name string, namePrefix string) string {
-	return NameWithSuffix(name, namePrefix, "")
+// Generated names follow the naming policy, if not nil; see NameWithSuffix.
+func Name(policy *NamingPolicy, name string, namePrefix string) string {
+	return NameWithSuffix(policy, name, namePrefix, "")
 }
 
 // NameWithSuffix returns in order the name if non-empty, a prefix generated name if non-empty, or fully generated name prefixed with "terraform-".
-// In the latter two cases, any suffix is appended to the generated name
-func NameWithSuffix(name string, namePrefix string, nameSuffix string) string {
+// In the latter two cases, any suffix is appended to the generated name.
+// If the naming policy is not nil, its prefix replaces "terraform-", its suffix is appended before any suffix and
+// the generated name is truncated to its maximum length.
+func NameWithSuffix(policy *NamingPolicy, name string, namePrefix string, nameSuffix string) string {
 	if name != "" {
 		return name
 	}
 
-	if namePrefix != "" {
-		return resource.PrefixedUniqueId(namePrefix) + nameSuffix
-	}
-
-	return resource.UniqueId() + nameSuffix
+	return policy.generate(namePrefix, nameSuffix)
 }
 
 // HasResourceUniqueIdSuffix returns true if the string has the built-in unique ID suffix
@@ -0,0 +1,176 @@
+package create
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// namingPolicyHashLength is the number of hexadecimal digits of the hash that replaces the truncated part of a long name.
+const namingPolicyHashLength = 8
+
+// NamingPolicy is a naming convention applied to resource names.
+type NamingPolicy struct {
+	// Prefix is required of configured names and name prefixes, and replaces "terraform-" in fully generated names.
+	Prefix string
+
+	// Suffix is appended to generated names, before any suffix required by the resource type, and required of configured names.
+	Suffix string
+
+	// Pattern, if not nil, must match configured names.
+	Pattern *regexp.Regexp
+
+	// MaxLength, if positive, is the maximum length of names.
+	// Longer generated names are truncated after their prefix, ending the truncated part with a hash of it to keep them unique.
+	MaxLength int
+
+	// ExcludeResourceTypes are resource types whose configured names and name prefixes are not checked.
+	ExcludeResourceTypes []string
+}
+
+// CheckName returns an error if a configured name of a resource of the given type does not follow the policy.
+func (p *NamingPolicy) CheckName(typeName, name string) error {
+	if p == nil || p.excludes(typeName) {
+		return nil
+	}
+
+	if !strings.HasPrefix(name, p.Prefix) {
+		return fmt.Errorf("name (%s) does not start with the provider naming prefix (%s)", name, p.Prefix)
+	}
+
+	if !strings.HasSuffix(name, p.Suffix) {
+		return fmt.Errorf("name (%s) does not end with the provider naming suffix (%s)", name, p.Suffix)
+	}
+
+	if p.Pattern != nil && !p.Pattern.MatchString(name) {
+		return fmt.Errorf("name (%s) does not match the provider naming pattern (%s)", name, p.Pattern)
+	}
+
+	if p.MaxLength > 0 && len(name) > p.MaxLength {
+		return fmt.Errorf("name (%s) is longer than the provider naming maximum length (%d)", name, p.MaxLength)
+	}
+
+	return nil
+}
+
+func (p *NamingPolicy) excludes(typeName string) bool {
+	for _, excluded := range p.ExcludeResourceTypes {
+		if typeName == excluded {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CheckNamePrefix returns an error if names generated from a configured name prefix of a resource of the given type
+// would not follow the policy.
+func (p *NamingPolicy) CheckNamePrefix(typeName, namePrefix string) error {
+	if p == nil || p.excludes(typeName) {
+		return nil
+	}
+
+	if !strings.HasPrefix(namePrefix, p.Prefix) {
+		return fmt.Errorf("name prefix (%s) does not start with the provider naming prefix (%s)", namePrefix, p.Prefix)
+	}
+
+	// Check a name as generated, with the longest unique ID.
+	name := namePrefix + strings.Repeat("0", resource.UniqueIDSuffixLength) + p.Suffix
+
+	if err := p.CheckName(typeName, name); err != nil {
+		return fmt.Errorf("names generated from name prefix (%s), e.g. %s: %w", namePrefix, name, err)
+	}
+
+	return nil
+}
+
+// CheckMaxLength returns an error if the policy's prefix and suffix leave no room for a unique name
+// within its maximum length.
+func (p *NamingPolicy) CheckMaxLength() error {
+	if p == nil || p.MaxLength <= 0 {
+		return nil
+	}
+
+	namePrefix := p.Prefix
+
+	if namePrefix == "" {
+		namePrefix = resource.UniqueIdPrefix
+	}
+
+	if len(namePrefix)+namingPolicyHashLength+len(p.Suffix) >= p.MaxLength {
+		return fmt.Errorf("prefix (%s) and suffix (%s) leave no room for a unique name within the maximum length (%d)", namePrefix, p.Suffix, p.MaxLength)
+	}
+
+	return nil
+}
+
+// generate returns a name generated from the name prefix, or from the policy's prefix if empty,
+// which follows the policy and ends with the resource type's required suffix.
+// If the prefixes and suffixes leave no room for a unique name within the policy's maximum length,
+// the name is not truncated.
+func (p *NamingPolicy) generate(namePrefix, nameSuffix string) string {
+	if namePrefix == "" && p != nil {
+		namePrefix = p.Prefix
+	}
+
+	if namePrefix == "" {
+		namePrefix = resource.UniqueIdPrefix
+	}
+
+	name, err := p.generatedName(resource.PrefixedUniqueId(namePrefix), namePrefix, nameSuffix)
+
+	if err != nil {
+		log.Printf("[WARN] Not truncating generated name (%s): %s", name, err)
+	}
+
+	return name
+}
+
+// NamePrefixFromName returns the name prefix of a name generated by NameWithSuffix without a suffix, if it is not truncated.
+func (p *NamingPolicy) NamePrefixFromName(name string) *string {
+	if p != nil && p.Suffix != "" {
+		if !strings.HasSuffix(name, p.Suffix) {
+			return nil
+		}
+
+		name = strings.TrimSuffix(name, p.Suffix)
+	}
+
+	return NamePrefixFromName(name)
+}
+
+// generatedName applies the policy to a generated name, which starts with the configured or default prefix,
+// and ends with the resource type's required suffix.
+// An error is returned, together with the untruncated name, if the prefix and suffixes leave no room
+// within the policy's maximum length for a unique name.
+func (p *NamingPolicy) generatedName(name, namePrefix, nameSuffix string) (string, error) {
+	if p == nil {
+		return name + nameSuffix, nil
+	}
+
+	name += p.Suffix
+
+	if p.MaxLength <= 0 || len(name)+len(nameSuffix) <= p.MaxLength {
+		return name + nameSuffix, nil
+	}
+
+	// Truncate the generated part of the name, so that the prefix and suffixes are kept.
+	tail := p.Suffix + nameSuffix
+	body := name[len(namePrefix) : len(name)-len(p.Suffix)]
+
+	n := p.MaxLength - len(namePrefix) - len(tail) - namingPolicyHashLength
+
+	if n < 0 {
+		return name + nameSuffix, fmt.Errorf("name prefix (%s) and suffix (%s) leave no room for a unique name within the provider naming maximum length (%d)", namePrefix, tail, p.MaxLength)
+	}
+
+	sum := sha256.Sum256([]byte(body))
+	hash := hex.EncodeToString(sum[:])[:namingPolicyHashLength]
+
+	return namePrefix + body[:n] + hash + tail, nil
+}
@@ -0,0 +1,243 @@
+package create
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestNamingPolicyCheckName(t *testing.T) {
+	policy := &NamingPolicy{
+		Prefix:               "acme-",
+		Suffix:               "-prod",
+		Pattern:              regexp.MustCompile(`^[a-z-]+$`),
+		MaxLength:            16,
+		ExcludeResourceTypes: []string{"aws_test_excluded"},
+	}
+
+	testCases := []struct {
+		TestName      string
+		TypeName      string
+		Name          string
+		ExpectedError string
+	}{
+		{
+			TestName: "valid",
+			TypeName: "aws_test",
+			Name:     "acme-test-prod",
+		},
+		{
+			TestName:      "missing prefix",
+			TypeName:      "aws_test",
+			Name:          "test-prod",
+			ExpectedError: "does not start with the provider naming prefix (acme-)",
+		},
+		{
+			TestName:      "missing suffix",
+			TypeName:      "aws_test",
+			Name:          "acme-test",
+			ExpectedError: "does not end with the provider naming suffix (-prod)",
+		},
+		{
+			TestName:      "pattern mismatch",
+			TypeName:      "aws_test",
+			Name:          "acme-Test-prod",
+			ExpectedError: "does not match the provider naming pattern",
+		},
+		{
+			TestName:      "too long",
+			TypeName:      "aws_test",
+			Name:          "acme-testing-prod",
+			ExpectedError: "is longer than the provider naming maximum length (16)",
+		},
+		{
+			TestName: "excluded resource type",
+			TypeName: "aws_test_excluded",
+			Name:     "Test",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			err := policy.CheckName(testCase.TypeName, testCase.Name)
+
+			if testCase.ExpectedError == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), testCase.ExpectedError) {
+				t.Errorf("got error %v, want %q", err, testCase.ExpectedError)
+			}
+		})
+	}
+}
+
+func TestNamingPolicyCheckNamePrefix(t *testing.T) {
+	policy := &NamingPolicy{
+		Prefix:    "acme-",
+		Suffix:    "-prod",
+		MaxLength: 41,
+	}
+
+	if err := policy.CheckNamePrefix("aws_test", "acme-test-"); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	if err := policy.CheckNamePrefix("aws_test", "test-"); err == nil {
+		t.Error("expected error for name prefix without the policy prefix")
+	}
+
+	// 11 + 26 + 5 characters, one more than the maximum.
+	if err := policy.CheckNamePrefix("aws_test", "acme-tests-"); err == nil || !strings.Contains(err.Error(), "maximum length") {
+		t.Errorf("got error %v, want maximum length error", err)
+	}
+
+	if err := (*NamingPolicy)(nil).CheckNamePrefix("aws_test", "test-"); err != nil {
+		t.Errorf("unexpected error for nil policy: %s", err)
+	}
+}
+
+func TestNamingPolicyGeneratedName(t *testing.T) {
+	name := "acme-" + strings.Repeat("0", 26)
+
+	testCases := []struct {
+		TestName       string
+		Policy         *NamingPolicy
+		NameSuffix     string
+		ExpectedPrefix string
+		ExpectedSuffix string
+		ExpectedLength int
+	}{
+		{
+			TestName:       "nil policy",
+			NameSuffix:     ".fifo",
+			ExpectedPrefix: name,
+			ExpectedSuffix: ".fifo",
+			ExpectedLength: len(name) + 5,
+		},
+		{
+			TestName:       "suffix",
+			Policy:         &NamingPolicy{Suffix: "-prod"},
+			NameSuffix:     ".fifo",
+			ExpectedPrefix: name,
+			ExpectedSuffix: "-prod.fifo",
+			ExpectedLength: len(name) + 10,
+		},
+		{
+			TestName:       "short enough",
+			Policy:         &NamingPolicy{Suffix: "-prod", MaxLength: 64},
+			ExpectedPrefix: name,
+			ExpectedSuffix: "-prod",
+			ExpectedLength: len(name) + 5,
+		},
+		{
+			TestName:       "truncated",
+			Policy:         &NamingPolicy{Suffix: "-prod", MaxLength: 24},
+			NameSuffix:     ".fifo",
+			ExpectedPrefix: "acme-",
+			ExpectedSuffix: "-prod.fifo",
+			ExpectedLength: 24,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got, err := testCase.Policy.generatedName(name, "acme-", testCase.NameSuffix)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !strings.HasPrefix(got, testCase.ExpectedPrefix) {
+				t.Errorf("got %s, want prefix %s", got, testCase.ExpectedPrefix)
+			}
+
+			if !strings.HasSuffix(got, testCase.ExpectedSuffix) {
+				t.Errorf("got %s, want suffix %s", got, testCase.ExpectedSuffix)
+			}
+
+			if len(got) != testCase.ExpectedLength {
+				t.Errorf("got %s (length %d), want length %d", got, len(got), testCase.ExpectedLength)
+			}
+		})
+	}
+}
+
+func TestNamingPolicyGeneratedNameUnique(t *testing.T) {
+	policy := &NamingPolicy{MaxLength: 20}
+
+	// Names differing only in their truncated part remain distinct.
+	name1, _ := policy.generatedName("acme-"+strings.Repeat("0", 25)+"1", "acme-", "")
+	name2, _ := policy.generatedName("acme-"+strings.Repeat("0", 25)+"2", "acme-", "")
+
+	if name1 == name2 {
+		t.Errorf("truncated names are equal: %s", name1)
+	}
+}
+
+func TestNamingPolicyGeneratedNameTooLong(t *testing.T) {
+	policy := &NamingPolicy{Suffix: "-prod", MaxLength: 16}
+
+	// 5 + 10 + 8 characters, leaving no room for the generated part of the name.
+	_, err := policy.generatedName("acme-"+strings.Repeat("0", 26), "acme-", ".fifo")
+
+	if err == nil || !strings.Contains(err.Error(), "leave no room for a unique name") {
+		t.Errorf("got error %v, want maximum length error", err)
+	}
+}
+
+func TestNamingPolicyCheckMaxLength(t *testing.T) {
+	// 5 + 8 + 5 characters, leaving room for the generated part of the name.
+	if err := (&NamingPolicy{Prefix: "acme-", Suffix: "-prod", MaxLength: 19}).CheckMaxLength(); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	if err := (&NamingPolicy{Prefix: "acme-", Suffix: "-prod", MaxLength: 18}).CheckMaxLength(); err == nil {
+		t.Error("expected error for naming policy maximum length")
+	}
+
+	// Without a prefix, fully generated names start with "terraform-".
+	if err := (&NamingPolicy{MaxLength: 18}).CheckMaxLength(); err == nil {
+		t.Error("expected error for naming policy maximum length without a prefix")
+	}
+}
+
+func TestNameNamingPolicy(t *testing.T) {
+	policy := &NamingPolicy{Prefix: "acme-", Suffix: "-prod"}
+
+	if got := Name(policy, "test", "acme-test-"); got != "test" {
+		t.Errorf("got %s, want configured name", got)
+	}
+
+	got := Name(policy, "", "")
+	expectedRegexp := regexp.MustCompile(resourcePrefixedUniqueIDPlusAdditionalSuffixRegexpPattern("acme-", "-prod"))
+
+	if !expectedRegexp.MatchString(got) {
+		t.Errorf("got %s, want match for %s", got, expectedRegexp)
+	}
+
+	got = Name(policy, "", "acme-test-")
+
+	if namePrefix := policy.NamePrefixFromName(got); namePrefix == nil || *namePrefix != "acme-test-" {
+		t.Errorf("name prefix from %s = %v, want acme-test-", got, namePrefix)
+	}
+
+	got = NameWithSuffix(policy, "", "", ".fifo")
+	expectedRegexp = regexp.MustCompile(resourcePrefixedUniqueIDPlusAdditionalSuffixRegexpPattern("acme-", `-prod\.fifo`))
+
+	if !expectedRegexp.MatchString(got) {
+		t.Errorf("got %s, want match for %s", got, expectedRegexp)
+	}
+
+	// Names are not truncated if the naming policy leaves no room for them.
+	policy.MaxLength = 12
+	expectedRegexp = regexp.MustCompile(resourcePrefixedUniqueIDPlusAdditionalSuffixRegexpPattern("acme-", "-prod"))
+
+	if got := Name(policy, "", ""); !expectedRegexp.MatchString(got) {
+		t.Errorf("got %s, want untruncated name", got)
+	}
+}
@@ -44,7 +44,7 @@ func TestName(t *testing.T) {
 
 	for _, testCase := range testCases {
 		t.Run(testCase.TestName, func(t *testing.T) {
-			got := Name(testCase.Name, testCase.NamePrefix)
+			got := Name(nil, testCase.Name, testCase.NamePrefix)
 
 			expectedRegexp, err := regexp.Compile(testCase.ExpectedRegexpPattern)
 
@@ -113,7 +113,7 @@ func TestNameWithSuffix(t *testing.T) {
 
 	for _, testCase := range testCases {
 		t.Run(testCase.TestName, func(t *testing.T) {
-			got := NameWithSuffix(testCase.Name, testCase.NamePrefix, testCase.NameSuffix)
+			got := NameWithSuffix(nil, testCase.Name, testCase.NamePrefix, testCase.NameSuffix)
 
 			expectedRegexp, err := regexp.Compile(testCase.ExpectedRegexpPattern)
 
@@ -282,7 +282,7 @@ func TestNamePrefixFromName(t *testing.T) {
 	t.Run("extracting prefix from generated name", func(t *testing.T) {
 		for i := 0; i < 10; i++ {
 			prefix := "test-"
-			input := Name("", prefix)
+			input := Name(nil, "", prefix)
 			got := NamePrefixFromName(input)
 
 			if got == nil {
@@ -377,7 +377,7 @@ func TestNamePrefixFromNameWithSuffix(t *testing.T) {
 	t.Run("extracting prefix from generated name", func(t *testing.T) {
 		for i := 0; i < 10; i++ {
 			prefix := "test-"
-			input := NameWithSuffix("", prefix, "suffix")
+			input := NameWithSuffix(nil, "", prefix, "suffix")
 			got := NamePrefixFromNameWithSuffix(input, "suffix")
 
 			if got == nil {
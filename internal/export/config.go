@@ -0,0 +1,212 @@
+package export
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const tagsAllAttributeName = "tags_all"
+
+// resourceConfig returns the configuration of a resource with the given attribute values,
+// in the form accepted by terraform.NewResourceConfigRaw.
+//
+// Computed-only attributes, and optional attributes and blocks whose values are their default or zero values,
+// are omitted so that the configuration only contains what is needed to reproduce the resource.
+// Of two conflicting attributes, only the first in lexical order is included.
+func resourceConfig(s map[string]*schema.Schema, values map[string]interface{}) map[string]interface{} {
+	config := map[string]interface{}{}
+
+	keys := make([]string, 0, len(s))
+
+	for k := range s {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		v := s[k]
+
+		if !v.Required && !v.Optional {
+			continue
+		}
+
+		// tags_all is computed from tags and the provider's default tags.
+		if k == tagsAllAttributeName {
+			continue
+		}
+
+		if conflicts(s, config, k) {
+			continue
+		}
+
+		value := normalize(values[k])
+
+		if elem, ok := v.Elem.(*schema.Resource); ok && (v.Type == schema.TypeList || v.Type == schema.TypeSet) {
+			blocks := nestedBlocks(elem, value, !v.Required)
+
+			if len(blocks) == 0 {
+				continue
+			}
+
+			config[k] = blocks
+
+			continue
+		}
+
+		if !v.Required && isDefault(v, value) {
+			continue
+		}
+
+		config[k] = value
+	}
+
+	return config
+}
+
+// nestedBlocks returns the configuration of each nested block.
+// Blocks with no configured attributes are omitted, as are blocks whose values are all defaults if omitDefault is set.
+func nestedBlocks(r *schema.Resource, value interface{}, omitDefault bool) []interface{} {
+	var blocks []interface{}
+
+	items, _ := value.([]interface{})
+
+	for _, item := range items {
+		values, ok := item.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if omitDefault && allDefault(r.Schema, values) {
+			continue
+		}
+
+		block := resourceConfig(r.Schema, values)
+
+		if len(block) == 0 && !hasRequired(r.Schema) {
+			continue
+		}
+
+		blocks = append(blocks, block)
+	}
+
+	return blocks
+}
+
+// conflicts returns whether the attribute k conflicts with an attribute already in config.
+func conflicts(s map[string]*schema.Schema, config map[string]interface{}, k string) bool {
+	for _, other := range s[k].ConflictsWith {
+		if _, ok := config[other]; ok {
+			return true
+		}
+	}
+
+	for other := range config {
+		for _, v := range s[other].ConflictsWith {
+			if v == k {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// allDefault returns whether all of a block's attributes, including required attributes, have default values.
+func allDefault(s map[string]*schema.Schema, values map[string]interface{}) bool {
+	for k, v := range s {
+		if !v.Required && !v.Optional {
+			continue
+		}
+
+		value := normalize(values[k])
+
+		if elem, ok := v.Elem.(*schema.Resource); ok && (v.Type == schema.TypeList || v.Type == schema.TypeSet) {
+			items, _ := value.([]interface{})
+
+			for _, item := range items {
+				if item, ok := item.(map[string]interface{}); ok && !allDefault(elem.Schema, item) {
+					return false
+				}
+			}
+
+			continue
+		}
+
+		if !isDefault(v, value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func hasRequired(s map[string]*schema.Schema) bool {
+	for _, v := range s {
+		if v.Required {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isDefault returns whether value is the attribute's default value or,
+// for attributes without a default, the zero value of its type.
+func isDefault(s *schema.Schema, value interface{}) bool {
+	if value == nil {
+		return true
+	}
+
+	if s.Default == nil {
+		return isZero(value)
+	}
+
+	// An empty string is not valid configuration for most attributes with a default.
+	if v, ok := value.(string); ok && v == "" {
+		return true
+	}
+
+	return fmt.Sprint(value) == fmt.Sprint(s.Default)
+}
+
+func isZero(value interface{}) bool {
+	switch v := value.(type) {
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	default:
+		return reflect.ValueOf(value).IsZero()
+	}
+}
+
+// normalize converts sets to lists.
+func normalize(value interface{}) interface{} {
+	switch v := value.(type) {
+	case *schema.Set:
+		return normalize(v.List())
+	case []interface{}:
+		items := make([]interface{}, len(v))
+
+		for i, item := range v {
+			items[i] = normalize(item)
+		}
+
+		return items
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+
+		for k, item := range v {
+			m[k] = normalize(item)
+		}
+
+		return m
+	default:
+		return value
+	}
+}
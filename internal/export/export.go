@@ -0,0 +1,229 @@
+// Package export reverse-engineers existing AWS infrastructure into Terraform configuration.
+//
+// Resources of the supported types are enumerated in the provider's region, imported and read
+// using the provider's own resource implementations, and converted into resource blocks containing
+// only the arguments needed to reproduce them, together with the import IDs needed to adopt them.
+package export
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/nij4t/terraform-provider-aws/internal/conns"
+)
+
+// Resource is an exported resource.
+type Resource struct {
+	// Type is the resource type, e.g. "aws_sqs_queue".
+	Type string
+
+	// Name is the resource's local name, unique within its type.
+	Name string
+
+	// ID is the resource's import ID.
+	ID string
+
+	// Config is the resource's configuration,
+	// in the form accepted by terraform.NewResourceConfigRaw.
+	Config map[string]interface{}
+
+	// Drift lists the attributes that would be changed by planning Config against the imported resource.
+	// It is empty unless the resource has arguments which cannot be reproduced from its state.
+	Drift []string
+
+	schema map[string]*schema.Schema
+}
+
+// Address returns the resource's address, e.g. "aws_sqs_queue.example".
+func (r *Resource) Address() string {
+	return r.Type + "." + r.Name
+}
+
+// SupportedTypes returns the resource types that can be exported.
+func SupportedTypes() []string {
+	types := make([]string, 0, len(listers))
+
+	for typeName := range listers {
+		types = append(types, typeName)
+	}
+
+	sort.Strings(types)
+
+	return types
+}
+
+// Exporter exports resources using a configured provider.
+type Exporter struct {
+	provider *schema.Provider
+}
+
+// New returns an Exporter for a provider whose meta is a configured *conns.AWSClient.
+func New(provider *schema.Provider) *Exporter {
+	return &Exporter{
+		provider: provider,
+	}
+}
+
+// Export exports all resources of the given types, ordered by type and then name.
+// Types that cannot be listed and resources that cannot be imported or read are logged and skipped.
+func (e *Exporter) Export(ctx context.Context, types []string) ([]*Resource, error) {
+	client, ok := e.provider.Meta().(*conns.AWSClient)
+
+	if !ok {
+		return nil, fmt.Errorf("provider is not configured")
+	}
+
+	var resources []*Resource
+
+	for _, typeName := range types {
+		if _, ok := listers[typeName]; !ok {
+			return nil, fmt.Errorf("exporting %s is not supported", typeName)
+		}
+	}
+
+	for _, typeName := range types {
+		ids, err := listers[typeName](ctx, client)
+
+		if err != nil {
+			log.Printf("[WARN] Unable to list %s, skipping: %s", typeName, err)
+			continue
+		}
+
+		names := map[string]struct{}{}
+
+		for _, id := range ids {
+			r, err := e.exportResource(ctx, client, typeName, id)
+
+			if err != nil {
+				log.Printf("[WARN] Unable to export %s (%s), skipping: %s", typeName, id, err)
+				continue
+			}
+
+			if r == nil {
+				log.Printf("[WARN] %s (%s) not found, skipping", typeName, id)
+				continue
+			}
+
+			r.Name = uniqueName(names, resourceName(id))
+			resources = append(resources, r)
+		}
+	}
+
+	sort.SliceStable(resources, func(i, j int) bool {
+		if resources[i].Type != resources[j].Type {
+			return resources[i].Type < resources[j].Type
+		}
+
+		return resources[i].Name < resources[j].Name
+	})
+
+	return resources, nil
+}
+
+// exportResource imports and reads a resource, returning nil if it does not exist.
+func (e *Exporter) exportResource(ctx context.Context, client *conns.AWSClient, typeName, id string) (*Resource, error) {
+	r := e.provider.ResourcesMap[typeName]
+	meta := e.provider.Meta()
+
+	states, err := e.provider.ImportState(ctx, &terraform.InstanceInfo{Type: typeName}, id)
+
+	if err != nil {
+		return nil, fmt.Errorf("error importing %s (%s): %w", typeName, id, err)
+	}
+
+	if len(states) != 1 {
+		return nil, fmt.Errorf("error importing %s (%s): expected 1 resource, got %d", typeName, id, len(states))
+	}
+
+	state, diags := r.RefreshWithoutUpgrade(ctx, states[0], meta)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("error reading %s (%s): %v", typeName, id, diags)
+	}
+
+	if state == nil || state.ID == "" {
+		return nil, nil
+	}
+
+	d := r.Data(state)
+	values := map[string]interface{}{}
+
+	for k := range r.Schema {
+		values[k] = d.Get(k)
+	}
+
+	// Resources are created in the provider's region unless configured otherwise.
+	if values["region"] == client.Region {
+		delete(values, "region")
+	}
+
+	config := resourceConfig(r.Schema, values)
+
+	diff, err := r.Diff(ctx, state, terraform.NewResourceConfigRaw(config), meta)
+
+	if err != nil {
+		return nil, fmt.Errorf("error planning %s (%s): %w", typeName, id, err)
+	}
+
+	var drift []string
+
+	if diff != nil {
+		for k := range diff.Attributes {
+			drift = append(drift, k)
+		}
+	}
+
+	sort.Strings(drift)
+
+	return &Resource{
+		Type:   typeName,
+		ID:     state.ID,
+		Config: config,
+		Drift:  drift,
+		schema: r.Schema,
+	}, nil
+}
+
+var invalidNameCharsRegexp = regexp.MustCompile(`[^a-zA-Z0-9_-]`)
+
+// resourceName derives a resource's local name from its import ID,
+// using the last element of an ARN, URL or path.
+func resourceName(id string) string {
+	name := id
+
+	if i := strings.LastIndexAny(name, ":/"); i >= 0 && i < len(name)-1 {
+		name = name[i+1:]
+	}
+
+	name = invalidNameCharsRegexp.ReplaceAllString(name, "_")
+
+	// Names must start with a letter or underscore.
+	if name == "" || !(name[0] == '_' || (name[0] >= 'a' && name[0] <= 'z') || (name[0] >= 'A' && name[0] <= 'Z')) {
+		name = "_" + name
+	}
+
+	return name
+}
+
+// uniqueName returns name, suffixed with a counter if it has already been used.
+func uniqueName(names map[string]struct{}, name string) string {
+	unique := name
+
+	for i := 2; ; i++ {
+		if _, ok := names[unique]; !ok {
+			break
+		}
+
+		unique = fmt.Sprintf("%s_%d", name, i)
+	}
+
+	names[unique] = struct{}{}
+
+	return unique
+}
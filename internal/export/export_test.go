@@ -0,0 +1,119 @@
+package export_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/nij4t/terraform-provider-aws/internal/acctest"
+	"github.com/nij4t/terraform-provider-aws/internal/export"
+)
+
+func TestExport(t *testing.T) {
+	p, _ := acctest.FakeAWSProvider(t)
+
+	acctest.NewFakeAWSResource(t, p, "aws_sqs_queue").Apply(map[string]interface{}{
+		"name":          "test.fifo",
+		"fifo_queue":    true,
+		"delay_seconds": 10,
+		"tags": map[string]interface{}{
+			"Name": "test",
+		},
+	})
+	acctest.NewFakeAWSResource(t, p, "aws_iam_role").Apply(map[string]interface{}{
+		"name":               "test",
+		"assume_role_policy": `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+		"description":        "Test",
+	})
+	acctest.NewFakeAWSResource(t, p, "aws_dynamodb_table").Apply(map[string]interface{}{
+		"name":         "test",
+		"hash_key":     "id",
+		"billing_mode": "PAY_PER_REQUEST",
+		"attribute": []interface{}{
+			map[string]interface{}{
+				"name": "id",
+				"type": "S",
+			},
+		},
+	})
+
+	resources, err := export.New(p).Export(context.Background(), export.SupportedTypes())
+
+	if err != nil {
+		t.Fatalf("error exporting: %s", err)
+	}
+
+	var addresses []string
+
+	for _, r := range resources {
+		addresses = append(addresses, r.Address())
+
+		if len(r.Drift) > 0 {
+			t.Errorf("%s does not plan clean: %s", r.Address(), strings.Join(r.Drift, ", "))
+		}
+	}
+
+	if got, want := strings.Join(addresses, ","), "aws_dynamodb_table.test,aws_iam_role.test,aws_sqs_queue.test_fifo"; got != want {
+		t.Errorf("exported %s, want %s", got, want)
+	}
+
+	var buf bytes.Buffer
+
+	if err := export.WriteResources(&buf, resources); err != nil {
+		t.Fatalf("error writing resources: %s", err)
+	}
+
+	hcl := buf.String()
+
+	for _, want := range []string{
+		`resource "aws_dynamodb_table" "test" {`,
+		`  billing_mode = "PAY_PER_REQUEST"`,
+		`  attribute {`,
+		`resource "aws_iam_role" "test" {`,
+		`  description        = "Test"`,
+		`resource "aws_sqs_queue" "test_fifo" {`,
+		`  delay_seconds                     = 10`,
+		`  name                              = "test.fifo"`,
+		`    Name = "test"`,
+	} {
+		if !strings.Contains(hcl, want+"\n") {
+			t.Errorf("resources do not contain %q:\n%s", want, hcl)
+		}
+	}
+
+	for _, unwanted := range []string{
+		// Computed-only.
+		"arn",
+		"tags_all",
+		// Default values.
+		"visibility_timeout_seconds",
+		"max_session_duration",
+		// Conflicts with name.
+		"name_prefix",
+	} {
+		if strings.Contains(hcl, unwanted+" ") {
+			t.Errorf("resources contain %q:\n%s", unwanted, hcl)
+		}
+	}
+
+	buf.Reset()
+
+	if err := export.WriteImportBlocks(&buf, resources); err != nil {
+		t.Fatalf("error writing import blocks: %s", err)
+	}
+
+	if want := "import {\n  to = aws_iam_role.test\n  id = \"test\"\n}\n"; !strings.Contains(buf.String(), want) {
+		t.Errorf("import blocks do not contain %q:\n%s", want, buf.String())
+	}
+
+	buf.Reset()
+
+	if err := export.WriteImportCommands(&buf, resources); err != nil {
+		t.Fatalf("error writing import commands: %s", err)
+	}
+
+	if want := "terraform import 'aws_dynamodb_table.test' 'test'\n"; !strings.Contains(buf.String(), want) {
+		t.Errorf("import commands do not contain %q:\n%s", want, buf.String())
+	}
+}
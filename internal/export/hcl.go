@@ -0,0 +1,209 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// WriteResources writes a resource block for each resource.
+func WriteResources(w io.Writer, resources []*Resource) error {
+	f := hclwrite.NewEmptyFile()
+	body := f.Body()
+
+	for i, r := range resources {
+		if i > 0 {
+			body.AppendNewline()
+		}
+
+		block := body.AppendNewBlock("resource", []string{r.Type, r.Name})
+
+		if err := writeBody(block.Body(), r.schema, r.Config); err != nil {
+			return fmt.Errorf("error writing %s: %w", r.Address(), err)
+		}
+	}
+
+	_, err := f.WriteTo(w)
+
+	return err
+}
+
+// WriteImportBlocks writes an import block for each resource.
+func WriteImportBlocks(w io.Writer, resources []*Resource) error {
+	f := hclwrite.NewEmptyFile()
+	body := f.Body()
+
+	for i, r := range resources {
+		if i > 0 {
+			body.AppendNewline()
+		}
+
+		block := body.AppendNewBlock("import", nil)
+		block.Body().SetAttributeTraversal("to", hcl.Traversal{
+			hcl.TraverseRoot{Name: r.Type},
+			hcl.TraverseAttr{Name: r.Name},
+		})
+		block.Body().SetAttributeValue("id", cty.StringVal(r.ID))
+	}
+
+	_, err := f.WriteTo(w)
+
+	return err
+}
+
+// WriteImportCommands writes a shell script with a terraform import command for each resource.
+func WriteImportCommands(w io.Writer, resources []*Resource) error {
+	if _, err := fmt.Fprint(w, "#!/bin/sh\nset -e\n\n"); err != nil {
+		return err
+	}
+
+	for _, r := range resources {
+		if _, err := fmt.Fprintf(w, "terraform import %s %s\n", shellQuote(r.Address()), shellQuote(r.ID)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// writeBody writes the arguments and then the nested blocks of a configuration, each in lexical order.
+func writeBody(body *hclwrite.Body, s map[string]*schema.Schema, config map[string]interface{}) error {
+	var attributes, blocks []string
+
+	for k := range config {
+		if _, ok := s[k].Elem.(*schema.Resource); ok {
+			blocks = append(blocks, k)
+		} else {
+			attributes = append(attributes, k)
+		}
+	}
+
+	sort.Strings(attributes)
+	sort.Strings(blocks)
+
+	for _, k := range attributes {
+		v, err := ctyValue(s[k], config[k])
+
+		if err != nil {
+			return fmt.Errorf("%s: %w", k, err)
+		}
+
+		body.SetAttributeValue(k, v)
+	}
+
+	for _, k := range blocks {
+		elem := s[k].Elem.(*schema.Resource)
+		items, _ := config[k].([]interface{})
+
+		for _, item := range items {
+			values, _ := item.(map[string]interface{})
+
+			if err := writeBody(body.AppendNewBlock(k, nil).Body(), elem.Schema, values); err != nil {
+				return fmt.Errorf("%s: %w", k, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// ctyValue converts an attribute value to a cty.Value.
+func ctyValue(s *schema.Schema, value interface{}) (cty.Value, error) {
+	switch s.Type {
+	case schema.TypeBool:
+		v, ok := value.(bool)
+
+		if !ok {
+			return cty.NilVal, fmt.Errorf("unexpected type %T", value)
+		}
+
+		return cty.BoolVal(v), nil
+	case schema.TypeInt:
+		v, ok := value.(int)
+
+		if !ok {
+			return cty.NilVal, fmt.Errorf("unexpected type %T", value)
+		}
+
+		return cty.NumberIntVal(int64(v)), nil
+	case schema.TypeFloat:
+		v, ok := value.(float64)
+
+		if !ok {
+			return cty.NilVal, fmt.Errorf("unexpected type %T", value)
+		}
+
+		return cty.NumberFloatVal(v), nil
+	case schema.TypeString:
+		v, ok := value.(string)
+
+		if !ok {
+			return cty.NilVal, fmt.Errorf("unexpected type %T", value)
+		}
+
+		return cty.StringVal(v), nil
+	case schema.TypeList, schema.TypeSet:
+		items, ok := value.([]interface{})
+
+		if !ok {
+			return cty.NilVal, fmt.Errorf("unexpected type %T", value)
+		}
+
+		var values []cty.Value
+
+		for _, item := range items {
+			v, err := ctyValue(elemSchema(s), item)
+
+			if err != nil {
+				return cty.NilVal, err
+			}
+
+			values = append(values, v)
+		}
+
+		// A tuple is written in the same way as a list, and its elements need not have a common type.
+		return cty.TupleVal(values), nil
+	case schema.TypeMap:
+		items, ok := value.(map[string]interface{})
+
+		if !ok {
+			return cty.NilVal, fmt.Errorf("unexpected type %T", value)
+		}
+
+		values := make(map[string]cty.Value, len(items))
+
+		for k, item := range items {
+			v, err := ctyValue(elemSchema(s), item)
+
+			if err != nil {
+				return cty.NilVal, err
+			}
+
+			values[k] = v
+		}
+
+		return cty.ObjectVal(values), nil
+	default:
+		return cty.NilVal, fmt.Errorf("unsupported type %s", s.Type)
+	}
+}
+
+// elemSchema returns the schema of the elements of a list, set or map attribute.
+// Map elements are strings unless specified otherwise.
+func elemSchema(s *schema.Schema) *schema.Schema {
+	if v, ok := s.Elem.(*schema.Schema); ok {
+		return v
+	}
+
+	return &schema.Schema{Type: schema.TypeString}
+}
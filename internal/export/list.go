@@ -0,0 +1,34 @@
+package export
+
+import (
+	"context"
+
+	"github.com/nij4t/terraform-provider-aws/internal/conns"
+	tfdynamodb "github.com/nij4t/terraform-provider-aws/internal/service/dynamodb"
+	tfiam "github.com/nij4t/terraform-provider-aws/internal/service/iam"
+	tfs3 "github.com/nij4t/terraform-provider-aws/internal/service/s3"
+	tfsns "github.com/nij4t/terraform-provider-aws/internal/service/sns"
+	tfsqs "github.com/nij4t/terraform-provider-aws/internal/service/sqs"
+)
+
+// lister returns the import IDs of all resources of a type in the client's region.
+type lister func(ctx context.Context, client *conns.AWSClient) ([]string, error)
+
+// listers are the resource types that can be exported.
+var listers = map[string]lister{
+	"aws_dynamodb_table": func(ctx context.Context, client *conns.AWSClient) ([]string, error) {
+		return tfdynamodb.FindDynamoDBTableNames(ctx, client.DynamoDBConn)
+	},
+	"aws_iam_role": func(ctx context.Context, client *conns.AWSClient) ([]string, error) {
+		return tfiam.FindRoleNames(ctx, client.IAMConn)
+	},
+	"aws_s3_bucket": func(ctx context.Context, client *conns.AWSClient) ([]string, error) {
+		return tfs3.FindBucketNamesByRegion(ctx, client.S3Conn, client.Region)
+	},
+	"aws_sns_topic": func(ctx context.Context, client *conns.AWSClient) ([]string, error) {
+		return tfsns.FindTopicARNs(ctx, client.SNSConn)
+	},
+	"aws_sqs_queue": func(ctx context.Context, client *conns.AWSClient) ([]string, error) {
+		return tfsqs.FindQueueURLs(ctx, client.SQSConn)
+	},
+}
@@ -0,0 +1,269 @@
+package cloudcontrol
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+// Generate returns the Go source of a typed resource in the given package.
+func Generate(r *Resource, packageName string) ([]byte, error) {
+	tmpl, err := template.New("resource").Funcs(template.FuncMap{
+		"lowerFirst": lowerFirst,
+	}).Parse(resourceTemplateBody)
+
+	if err != nil {
+		return nil, fmt.Errorf("error parsing template: %w", err)
+	}
+
+	var buffer bytes.Buffer
+
+	err = tmpl.Execute(&buffer, struct {
+		Package  string
+		Resource *Resource
+	}{
+		Package:  packageName,
+		Resource: r,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error executing template: %w", err)
+	}
+
+	generatedFileContents, err := format.Source(buffer.Bytes())
+
+	if err != nil {
+		return nil, fmt.Errorf("error formatting generated file: %w", err)
+	}
+
+	return generatedFileContents, nil
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+
+	r := []rune(s)
+	r[0] = unicode.ToLower(r[0])
+
+	return string(r)
+}
+
+var resourceTemplateBody = strings.TrimLeft(`
+// Code generated by internal/generate/cloudcontrol/main.go; DO NOT EDIT.
+
+package {{ .Package }}
+
+{{- $name := .Resource.Name }}
+{{- $lowerName := lowerFirst .Resource.Name }}
+{{- $typeName := .Resource.CloudFormationTypeName }}
+
+import (
+	"context"
+	"fmt"
+	"log"
+{{- if .Resource.Uses "regexp" }}
+	"regexp"
+{{- end }}
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+{{- if .Resource.Uses "validation" }}
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+{{- end }}
+	"github.com/nij4t/terraform-provider-aws/internal/conns"
+	tfcloudcontrol "github.com/nij4t/terraform-provider-aws/internal/service/cloudcontrol"
+	"github.com/nij4t/terraform-provider-aws/internal/tfresource"
+{{- if .Resource.Uses "verify" }}
+	"github.com/nij4t/terraform-provider-aws/internal/verify"
+{{- end }}
+)
+
+const {{ $lowerName }}TypeName = "{{ $typeName }}"
+
+// Resource{{ $name }} manages {{ $typeName }} resources through Cloud Control API.
+func Resource{{ $name }}() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resource{{ $name }}Create,
+		ReadContext:   resource{{ $name }}Read,
+{{- if .Resource.Updatable }}
+		UpdateContext: resource{{ $name }}Update,
+{{- end }}
+		DeleteContext: resource{{ $name }}Delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Hour),
+{{- if .Resource.Updatable }}
+			Update: schema.DefaultTimeout(2 * time.Hour),
+{{- end }}
+			Delete: schema.DefaultTimeout(2 * time.Hour),
+		},
+
+		Schema: map[string]*schema.Schema{
+{{- range .Resource.Attributes }}
+			"{{ .Name }}": {{ template "schema" . }},
+{{- end }}
+		},
+	}
+}
+
+var {{ $lowerName }}Properties = map[string]tfcloudcontrol.Property{
+{{- range .Resource.Attributes }}
+	"{{ .Name }}": {{ template "property" . }},
+{{- end }}
+}
+
+func resource{{ $name }}Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudControlConn
+
+	desiredState, err := tfcloudcontrol.DesiredState(d, {{ $lowerName }}Properties)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error expanding %s desired state: %w", {{ $lowerName }}TypeName, err))
+	}
+
+	id, err := tfcloudcontrol.CreateResource(ctx, conn, {{ $lowerName }}TypeName, desiredState, d.Timeout(schema.TimeoutCreate))
+
+	// Always try to capture the identifier before returning errors
+	if id != "" {
+		d.SetId(id)
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating %s: %w", {{ $lowerName }}TypeName, err))
+	}
+
+	return resource{{ $name }}Read(ctx, d, meta)
+}
+
+func resource{{ $name }}Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudControlConn
+
+	resourceDescription, err := tfcloudcontrol.FindResourceByID(ctx, conn, d.Id(), {{ $lowerName }}TypeName, "", "")
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] %s (%s) not found, removing from state", {{ $lowerName }}TypeName, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading %s (%s): %w", {{ $lowerName }}TypeName, d.Id(), err))
+	}
+
+	if err := tfcloudcontrol.SetProperties(d, {{ $lowerName }}Properties, aws.StringValue(resourceDescription.Properties)); err != nil {
+		return diag.FromErr(fmt.Errorf("error reading %s (%s): %w", {{ $lowerName }}TypeName, d.Id(), err))
+	}
+
+	return nil
+}
+{{- if .Resource.Updatable }}
+
+func resource{{ $name }}Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudControlConn
+
+	oldDesiredState, newDesiredState, err := tfcloudcontrol.DesiredStateChange(d, {{ $lowerName }}Properties)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error expanding %s (%s) desired state: %w", {{ $lowerName }}TypeName, d.Id(), err))
+	}
+
+	if oldDesiredState != newDesiredState {
+		if err := tfcloudcontrol.UpdateResource(ctx, conn, {{ $lowerName }}TypeName, d.Id(), oldDesiredState, newDesiredState, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating %s (%s): %w", {{ $lowerName }}TypeName, d.Id(), err))
+		}
+	}
+
+	return resource{{ $name }}Read(ctx, d, meta)
+}
+{{- end }}
+
+func resource{{ $name }}Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudControlConn
+
+	log.Printf("[DEBUG] Deleting %s: %s", {{ $lowerName }}TypeName, d.Id())
+	if err := tfcloudcontrol.DeleteResource(ctx, conn, {{ $lowerName }}TypeName, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting %s (%s): %w", {{ $lowerName }}TypeName, d.Id(), err))
+	}
+
+	return nil
+}
+
+{{- define "schema" }}{
+	Type: schema.{{ .Type }},
+{{- if .Required }}
+	Required: true,
+{{- end }}
+{{- if .Optional }}
+	Optional: true,
+{{- end }}
+{{- if .Computed }}
+	Computed: true,
+{{- end }}
+{{- if .ForceNew }}
+	ForceNew: true,
+{{- end }}
+{{- if .Sensitive }}
+	Sensitive: true,
+{{- end }}
+{{- if .MaxItems }}
+	MaxItems: {{ .MaxItems }},
+{{- end }}
+{{- if .MinItems }}
+	MinItems: {{ .MinItems }},
+{{- end }}
+{{- if .ValidateFunc }}
+	ValidateFunc: {{ .ValidateFunc }},
+{{- end }}
+{{- if and .JSON (not .ReadOnly) }}
+	DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+{{- end }}
+{{- if .Elem }}
+	Elem: &schema.Schema{{ template "schema" .Elem }},
+{{- end }}
+{{- if .Attributes }}
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+{{- range .Attributes }}
+			"{{ .Name }}": {{ template "schema" . }},
+{{- end }}
+		},
+	},
+{{- end }}
+}
+{{- end }}
+
+{{- define "property" }}{
+	Name: "{{ .PropertyName }}",
+{{- if .Object }}
+	Object: true,
+{{- end }}
+{{- if .JSON }}
+	JSON: true,
+{{- end }}
+{{- if .ReadOnly }}
+	ReadOnly: true,
+{{- end }}
+{{- if .WriteOnly }}
+	WriteOnly: true,
+{{- end }}
+{{- if .Attributes }}
+	Properties: map[string]tfcloudcontrol.Property{
+{{- range .Attributes }}
+		"{{ .Name }}": {{ template "property" . }},
+{{- end }}
+	},
+{{- end }}
+}
+{{- end }}
+`, "\n")
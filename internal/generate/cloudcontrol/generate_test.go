@@ -0,0 +1,123 @@
+package cloudcontrol
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	testCases := []struct {
+		schemaFile    string
+		generatedFile string
+	}{
+		{
+			schemaFile:    "aws-logs-loggroup.json",
+			generatedFile: "log_group_gen.go",
+		},
+		{
+			schemaFile:    "aws-memorydb-user.json",
+			generatedFile: "user_gen.go",
+		},
+		{
+			schemaFile:    "aws-ssm-parameter.json",
+			generatedFile: "parameter_gen.go",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.schemaFile, func(t *testing.T) {
+			schema, err := os.ReadFile(filepath.Join("testdata", testCase.schemaFile))
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			r, err := NewResource(string(schema), "")
+
+			if err != nil {
+				t.Fatalf("error converting schema: %s", err)
+			}
+
+			got, err := Generate(r, "testresources")
+
+			if err != nil {
+				t.Fatalf("error generating resource: %s", err)
+			}
+
+			// The generated resources are compiled and validated in the testresources package.
+			want, err := os.ReadFile(filepath.Join("testresources", testCase.generatedFile))
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if string(got) != string(want) {
+				t.Errorf("generated resource differs from testresources/%s, run go generate ./internal/generate/cloudcontrol/testresources:\n%s", testCase.generatedFile, got)
+			}
+		})
+	}
+}
+
+func TestNewResource(t *testing.T) {
+	schema, err := os.ReadFile(filepath.Join("testdata", "aws-memorydb-user.json"))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := NewResource(string(schema), "")
+
+	if err != nil {
+		t.Fatalf("error converting schema: %s", err)
+	}
+
+	attributes := map[string]*Attribute{}
+
+	for _, a := range r.Attributes {
+		attributes[a.Name] = a
+	}
+
+	if a := attributes["user_name"]; a == nil || !a.Required || !a.ForceNew {
+		t.Errorf("user_name: want Required, ForceNew, got %+v", a)
+	}
+
+	if a := attributes["arn"]; a == nil || !a.Computed || a.Optional || !a.ReadOnly {
+		t.Errorf("arn: want Computed, ReadOnly, got %+v", a)
+	}
+
+	if a := attributes["access_string"]; a == nil || !a.Sensitive || a.Computed || !a.WriteOnly {
+		t.Errorf("access_string: want Sensitive, WriteOnly, not Computed, got %+v", a)
+	}
+
+	a := attributes["authentication_mode"]
+
+	if a == nil || !a.Object || a.MaxItems != 1 || len(a.Attributes) != 2 {
+		t.Fatalf("authentication_mode: want nested block with 2 attributes, got %+v", a)
+	}
+
+	if passwords := a.Attributes[0]; passwords.Name != "passwords" || !passwords.Sensitive || passwords.Type != "TypeList" || passwords.Elem == nil || passwords.MaxItems != 2 {
+		t.Errorf("authentication_mode.passwords: want sensitive list of at most 2, got %+v", passwords)
+	}
+
+	if a := attributes["tags"]; a == nil || a.Type != "TypeSet" || a.Object || len(a.Attributes) != 2 || !a.Attributes[0].Required {
+		t.Errorf("tags: want set of blocks with required key, got %+v", a)
+	}
+}
+
+func TestSnakeCase(t *testing.T) {
+	testCases := map[string]string{
+		"Arn":          "arn",
+		"ARN":          "arn",
+		"KmsKeyId":     "kms_key_id",
+		"VPCId":        "vpc_id",
+		"S3Bucket":     "s3_bucket",
+		"MaxCapacity2": "max_capacity2",
+	}
+
+	for input, want := range testCases {
+		if got := snakeCase(input); got != want {
+			t.Errorf("snakeCase(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/nij4t/terraform-provider-aws/internal/generate/cloudcontrol"
+)
+
+var (
+	name       = flag.String("name", "", "resource Go name, defaulting to the last part of the CloudFormation type name")
+	outputFile = flag.String("file", "", "output file")
+	schemaFile = flag.String("schema", "", "CloudFormation resource schema file")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [flags]\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	log.SetFlags(0)
+	flag.Usage = usage
+	flag.Parse()
+
+	if *schemaFile == "" || *outputFile == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	servicePackage := os.Getenv("GOPACKAGE")
+
+	schema, err := os.ReadFile(*schemaFile)
+
+	if err != nil {
+		log.Fatalf("error reading schema: %s", err)
+	}
+
+	r, err := cloudcontrol.NewResource(string(schema), *name)
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	generatedFileContents, err := cloudcontrol.Generate(r, servicePackage)
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile(*outputFile, generatedFileContents, 0644); err != nil {
+		log.Fatalf("error writing to file (%s): %s", *outputFile, err)
+	}
+}
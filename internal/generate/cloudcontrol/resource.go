@@ -0,0 +1,520 @@
+// Package cloudcontrol generates typed Terraform resources from CloudFormation resource schemas.
+//
+// The generated resources perform CRUD operations through Cloud Control API,
+// using the helpers in internal/service/cloudcontrol to convert between
+// Terraform attributes and CloudFormation properties.
+package cloudcontrol
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	cfschema "github.com/hashicorp/aws-cloudformation-resource-schema-sdk-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Resource is a typed resource generated from a CloudFormation resource schema.
+type Resource struct {
+	// Name is the resource's Go name, e.g. "LogGroup" for the ResourceLogGroup function.
+	Name string
+
+	// CloudFormationTypeName is the CloudFormation resource type, e.g. "AWS::Logs::LogGroup".
+	CloudFormationTypeName string
+
+	// Attributes are the resource's top-level attributes, ordered by name.
+	Attributes []*Attribute
+}
+
+// Attribute is a Terraform attribute or nested block mapped to a CloudFormation property.
+type Attribute struct {
+	// Name is the Terraform attribute name, e.g. "kms_key_id".
+	Name string
+
+	// PropertyName is the CloudFormation property name, e.g. "KmsKeyId".
+	PropertyName string
+
+	// Type is the attribute's schema.ValueType, e.g. "TypeString".
+	Type string
+
+	Required  bool
+	Optional  bool
+	Computed  bool
+	ForceNew  bool
+	Sensitive bool
+
+	MaxItems int
+	MinItems int
+
+	// ValidateFunc is a Go expression for the attribute's validation function, if any.
+	ValidateFunc string
+
+	// Elem is the schema of the elements of a list, set or map of primitives.
+	Elem *Attribute
+
+	// Attributes are the attributes of a nested block, ordered by name.
+	Attributes []*Attribute
+
+	// Object is set for a nested block holding a single object.
+	Object bool
+
+	// JSON is set for a string attribute holding a JSON-encoded value.
+	JSON bool
+
+	ReadOnly  bool
+	WriteOnly bool
+}
+
+// reservedNames are attribute names which cannot be used for top-level attributes.
+var reservedNames = append([]string{"id", "timeouts"}, schema.ReservedResourceFields...)
+
+// NewResource returns a typed resource for a CloudFormation resource schema.
+// If name is empty, it is derived from the CloudFormation resource type.
+func NewResource(schemaJSON, name string) (*Resource, error) {
+	doc, err := cfschema.NewResourceJsonSchemaDocument(cfschema.Sanitize(schemaJSON))
+
+	if err != nil {
+		return nil, fmt.Errorf("error parsing CloudFormation resource schema: %w", err)
+	}
+
+	if _, err := doc.Resource(); err != nil {
+		return nil, fmt.Errorf("error converting CloudFormation resource schema: %w", err)
+	}
+
+	// Sanitizing the schema removes the patterns used for validation, so decode the original.
+	cfResource := &cfschema.Resource{}
+
+	if err := json.Unmarshal([]byte(schemaJSON), cfResource); err != nil {
+		return nil, fmt.Errorf("error decoding CloudFormation resource schema: %w", err)
+	}
+
+	if cfResource.TypeName == nil {
+		return nil, fmt.Errorf("CloudFormation resource schema has no typeName")
+	}
+
+	if err := cfResource.Expand(); err != nil {
+		return nil, err
+	}
+
+	typeName := *cfResource.TypeName
+
+	if name == "" {
+		name = typeName[strings.LastIndex(typeName, ":")+1:]
+	}
+
+	g := &generator{
+		resource: cfResource,
+		prefix:   snakeCase(name),
+	}
+
+	attributes, err := g.attributes(cfResource.Properties, cfResource.Required, nil, false, false)
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", typeName, err)
+	}
+
+	for _, a := range attributes {
+		for _, reserved := range reservedNames {
+			if a.Name == reserved {
+				a.Name = g.prefix + "_" + a.Name
+			}
+		}
+	}
+
+	sort.Slice(attributes, func(i, j int) bool {
+		return attributes[i].Name < attributes[j].Name
+	})
+
+	return &Resource{
+		Name:                   name,
+		CloudFormationTypeName: typeName,
+		Attributes:             attributes,
+	}, nil
+}
+
+// Updatable returns whether any attribute can be updated in-place.
+func (r *Resource) Updatable() bool {
+	for _, a := range r.Attributes {
+		if !a.ForceNew && !a.ReadOnly {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Uses returns whether any attribute's schema uses the given package,
+// so that only the imports needed are generated.
+func (r *Resource) Uses(pkg string) bool {
+	return uses(r.Attributes, pkg)
+}
+
+func uses(attributes []*Attribute, pkg string) bool {
+	for _, a := range attributes {
+		if strings.Contains(a.ValidateFunc, pkg+".") || (pkg == "verify" && a.JSON && !a.ReadOnly) {
+			return true
+		}
+
+		if a.Elem != nil && strings.Contains(a.Elem.ValidateFunc, pkg+".") {
+			return true
+		}
+
+		if uses(a.Attributes, pkg) {
+			return true
+		}
+	}
+
+	return false
+}
+
+type generator struct {
+	resource *cfschema.Resource
+	prefix   string
+}
+
+// attributes converts the properties of an object, found at path, to attributes.
+// createOnly and writeOnly are inherited from the enclosing property.
+func (g *generator) attributes(properties map[string]*cfschema.Property, required []string, path []string, createOnly, writeOnly bool) ([]*Attribute, error) {
+	var attributes []*Attribute
+
+	for propertyName, property := range properties {
+		propertyPath := append(append([]string{}, path...), propertyName)
+
+		a := &Attribute{
+			Name:         snakeCase(propertyName),
+			PropertyName: propertyName,
+			ReadOnly:     matchesPath(g.resource.ReadOnlyProperties, propertyPath),
+			WriteOnly:    writeOnly || matchesPath(g.resource.WriteOnlyProperties, propertyPath),
+		}
+
+		forceNew := createOnly || matchesPath(g.resource.CreateOnlyProperties, propertyPath)
+
+		switch {
+		case a.ReadOnly:
+			a.Computed = true
+		case contains(required, propertyName):
+			a.Required = true
+		case a.WriteOnly:
+			// Write-only properties are never read, so cannot be computed.
+			a.Optional = true
+		default:
+			a.Optional = true
+			a.Computed = true
+		}
+
+		if !a.ReadOnly {
+			a.ForceNew = forceNew
+			a.Sensitive = a.WriteOnly
+		}
+
+		if err := g.setType(a, property, propertyPath, forceNew); err != nil {
+			return nil, fmt.Errorf("%s: %w", propertyName, err)
+		}
+
+		attributes = append(attributes, a)
+	}
+
+	sort.Slice(attributes, func(i, j int) bool {
+		return attributes[i].Name < attributes[j].Name
+	})
+
+	return attributes, nil
+}
+
+// setType sets an attribute's type, nested attributes and validation from its property.
+func (g *generator) setType(a *Attribute, property *cfschema.Property, path []string, forceNew bool) error {
+	switch property.Type.String() {
+	case cfschema.PropertyTypeBoolean, cfschema.PropertyTypeInteger, cfschema.PropertyTypeNumber, cfschema.PropertyTypeString:
+		a.Type = primitiveType(property)
+		a.ValidateFunc = g.validateFunc(a, property)
+	case cfschema.PropertyTypeArray:
+		items := property.Items
+
+		if items == nil {
+			g.setJSON(a)
+			break
+		}
+
+		a.Type = "TypeList"
+
+		if property.InsertionOrder != nil && !*property.InsertionOrder {
+			a.Type = "TypeSet"
+		}
+
+		if !a.ReadOnly {
+			if property.MaxItems != nil {
+				a.MaxItems = *property.MaxItems
+			}
+
+			if property.MinItems != nil {
+				a.MinItems = *property.MinItems
+			}
+		}
+
+		switch items.Type.String() {
+		case cfschema.PropertyTypeBoolean, cfschema.PropertyTypeInteger, cfschema.PropertyTypeNumber, cfschema.PropertyTypeString:
+			a.Elem = &Attribute{
+				Type: primitiveType(items),
+			}
+
+			if !a.ReadOnly {
+				a.Elem.ValidateFunc = g.validateFunc(a.Elem, items)
+			}
+		case cfschema.PropertyTypeObject, "":
+			if len(items.Properties) == 0 {
+				g.setJSON(a)
+				break
+			}
+
+			attributes, err := g.attributes(items.Properties, items.Required, path, forceNew, a.WriteOnly)
+
+			if err != nil {
+				return err
+			}
+
+			g.setNested(a, attributes)
+		default:
+			g.setJSON(a)
+		}
+	case cfschema.PropertyTypeObject, "":
+		if len(property.Properties) > 0 {
+			attributes, err := g.attributes(property.Properties, property.Required, path, forceNew, a.WriteOnly)
+
+			if err != nil {
+				return err
+			}
+
+			a.Type = "TypeList"
+			a.Object = true
+
+			if !a.ReadOnly {
+				a.MaxItems = 1
+			}
+
+			g.setNested(a, attributes)
+
+			break
+		}
+
+		// A map with primitive values.
+		if len(property.PatternProperties) == 1 {
+			for _, v := range property.PatternProperties {
+				switch v.Type.String() {
+				case cfschema.PropertyTypeBoolean, cfschema.PropertyTypeInteger, cfschema.PropertyTypeNumber, cfschema.PropertyTypeString:
+					a.Type = "TypeMap"
+					a.Elem = &Attribute{
+						Type: primitiveType(v),
+					}
+				}
+			}
+
+			if a.Type != "" {
+				break
+			}
+		}
+
+		g.setJSON(a)
+	default:
+		return fmt.Errorf("unsupported type %q", property.Type.String())
+	}
+
+	return nil
+}
+
+// setNested sets the nested attributes of a block.
+// Nested attributes are read-only if the block is.
+func (g *generator) setNested(a *Attribute, attributes []*Attribute) {
+	if a.ReadOnly {
+		for _, nested := range attributes {
+			setReadOnly(nested)
+		}
+	}
+
+	a.Attributes = attributes
+}
+
+func setReadOnly(a *Attribute) {
+	*a = Attribute{
+		Name:         a.Name,
+		PropertyName: a.PropertyName,
+		Type:         a.Type,
+		Computed:     true,
+		Elem:         a.Elem,
+		Attributes:   a.Attributes,
+		Object:       a.Object,
+		JSON:         a.JSON,
+		ReadOnly:     true,
+	}
+
+	if a.Elem != nil {
+		a.Elem = &Attribute{Type: a.Elem.Type}
+	}
+
+	for _, nested := range a.Attributes {
+		setReadOnly(nested)
+	}
+}
+
+// setJSON makes an attribute a JSON-encoded string.
+func (g *generator) setJSON(a *Attribute) {
+	a.Type = "TypeString"
+	a.JSON = true
+	a.MaxItems = 0
+	a.MinItems = 0
+	a.Elem = nil
+
+	if !a.ReadOnly {
+		a.ValidateFunc = "validation.StringIsJSON"
+	}
+}
+
+// validateFunc returns the validation function for a primitive attribute, if any.
+func (g *generator) validateFunc(a *Attribute, property *cfschema.Property) string {
+	if a.ReadOnly {
+		return ""
+	}
+
+	var funcs []string
+
+	switch a.Type {
+	case "TypeString":
+		if len(property.Enum) > 0 {
+			var values []string
+
+			for _, v := range property.Enum {
+				values = append(values, strconv.Quote(fmt.Sprint(v)))
+			}
+
+			funcs = append(funcs, fmt.Sprintf("validation.StringInSlice([]string{%s}, false)", strings.Join(values, ", ")))
+			break
+		}
+
+		if property.MinLength != nil || property.MaxLength != nil {
+			min, max := 0, 0
+
+			if property.MinLength != nil {
+				min = *property.MinLength
+			}
+
+			if property.MaxLength != nil {
+				max = *property.MaxLength
+			}
+
+			if max > 0 {
+				funcs = append(funcs, fmt.Sprintf("validation.StringLenBetween(%d, %d)", min, max))
+			} else if min > 0 {
+				funcs = append(funcs, fmt.Sprintf("validation.StringLenBetween(%d, %d)", min, maxStringLength))
+			}
+		}
+
+		// CloudFormation resource schemas use ECMA-262 regular expressions,
+		// so patterns which are not valid Go regular expressions are not validated.
+		if property.Pattern != nil && *property.Pattern != "" {
+			if _, err := regexp.Compile(*property.Pattern); err == nil {
+				funcs = append(funcs, fmt.Sprintf("validation.StringMatch(regexp.MustCompile(%s), \"\")", strconv.Quote(*property.Pattern)))
+			}
+		}
+	case "TypeInt":
+		if len(property.Enum) > 0 {
+			var values []string
+
+			for _, v := range property.Enum {
+				values = append(values, fmt.Sprint(v))
+			}
+
+			funcs = append(funcs, fmt.Sprintf("validation.IntInSlice([]int{%s})", strings.Join(values, ", ")))
+			break
+		}
+
+		switch {
+		case property.Minimum != nil && property.Maximum != nil:
+			funcs = append(funcs, fmt.Sprintf("validation.IntBetween(%d, %d)", *property.Minimum, *property.Maximum))
+		case property.Minimum != nil:
+			funcs = append(funcs, fmt.Sprintf("validation.IntAtLeast(%d)", *property.Minimum))
+		case property.Maximum != nil:
+			funcs = append(funcs, fmt.Sprintf("validation.IntAtMost(%d)", *property.Maximum))
+		}
+	}
+
+	switch len(funcs) {
+	case 0:
+		return ""
+	case 1:
+		return funcs[0]
+	default:
+		return fmt.Sprintf("validation.All(\n%s,\n)", strings.Join(funcs, ",\n"))
+	}
+}
+
+// maxStringLength is the maximum length used when a string property has only a minimum length.
+const maxStringLength = 1<<31 - 1
+
+func primitiveType(property *cfschema.Property) string {
+	switch property.Type.String() {
+	case cfschema.PropertyTypeBoolean:
+		return "TypeBool"
+	case cfschema.PropertyTypeInteger:
+		return "TypeInt"
+	case cfschema.PropertyTypeNumber:
+		return "TypeFloat"
+	default:
+		return "TypeString"
+	}
+}
+
+// matchesPath returns whether any of the JSON pointers refers to the property at path.
+// Array item ("*") and nested "properties" segments are ignored.
+func matchesPath(pointers cfschema.PropertyJsonPointers, path []string) bool {
+	for _, pointer := range pointers {
+		var segments []string
+
+		for _, segment := range pointer.Path() {
+			if segment != "*" && segment != cfschema.PropertiesJsonPointerReferenceToken {
+				segments = append(segments, segment)
+			}
+		}
+
+		if strings.Join(segments, "/") == strings.Join(path, "/") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// snakeCase converts a CloudFormation property name to a Terraform attribute name,
+// e.g. "KmsKeyId" to "kms_key_id" and "VPCId" to "vpc_id".
+func snakeCase(s string) string {
+	runes := []rune(s)
+
+	var b strings.Builder
+
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 {
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				b.WriteRune('_')
+			}
+		}
+
+		b.WriteRune(unicode.ToLower(r))
+	}
+
+	return b.String()
+}
@@ -0,0 +1,7 @@
+//go:generate go run ../main.go -schema ../testdata/aws-logs-loggroup.json -file log_group_gen.go
+//go:generate go run ../main.go -schema ../testdata/aws-memorydb-user.json -file user_gen.go
+//go:generate go run ../main.go -schema ../testdata/aws-ssm-parameter.json -file parameter_gen.go
+
+// Package testresources contains the resources generated from the CloudFormation resource schemas in ../testdata.
+// They are compiled and validated to test the generator, and are not registered with the provider.
+package testresources
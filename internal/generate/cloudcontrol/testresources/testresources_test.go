@@ -0,0 +1,19 @@
+package testresources
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourcesInternalValidate(t *testing.T) {
+	for name, r := range map[string]*schema.Resource{
+		"ResourceLogGroup":  ResourceLogGroup(),
+		"ResourceParameter": ResourceParameter(),
+		"ResourceUser":      ResourceUser(),
+	} {
+		if err := r.InternalValidate(nil, true); err != nil {
+			t.Errorf("%s: %s", name, err)
+		}
+	}
+}
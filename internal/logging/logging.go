@@ -0,0 +1,193 @@
+// Package logging writes structured log entries for the AWS API requests made by the provider.
+//
+// Request handlers installed on each AWS SDK for Go session log a summary of every request
+// (service, operation, request ID, HTTP status, duration and retry count) and, when debug logging
+// is enabled, the details of each HTTP request and response with sensitive headers and body fields masked.
+// Requests made with a context returned by WithResource are logged using the provider's structured
+// logger (tflog) together with the type and ID of the resource or data source making the request.
+// Requests made by clients with a handler added by AddResourceRequestHandler are logged using the
+// standard library logger together with the type and ID. Other requests are logged using the standard
+// library logger.
+package logging
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdklogging "github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
+)
+
+// Log entry field keys.
+const (
+	KeyResourceType = "tf_aws.resource_type"
+	KeyResourceID   = "tf_aws.resource_id"
+
+	KeyService    = "aws.service"
+	KeyOperation  = "aws.operation"
+	KeyRequestID  = "aws.request_id"
+	KeyRetryCount = "aws.retry_count"
+	KeyErrorCode  = "aws.error_code"
+	KeyDuration   = "aws.duration_ms"
+
+	KeyHTTPMethod          = "http.method"
+	KeyHTTPURL             = "http.url"
+	KeyHTTPStatusCode      = "http.status_code"
+	KeyHTTPRequestHeaders  = "http.request.headers"
+	KeyHTTPRequestBody     = "http.request.body"
+	KeyHTTPResponseHeaders = "http.response.headers"
+	KeyHTTPResponseBody    = "http.response.body"
+)
+
+type resourceContextKey struct{}
+
+type resource struct {
+	typeName string
+	id       string
+
+	// structured is set if the context carries the provider's structured logger.
+	structured bool
+}
+
+// WithResource returns a copy of ctx that identifies the resource or data source making AWS API requests.
+// ctx should be the context passed to the resource's CRUD function, which carries the provider's logger.
+// Terraform does not send resource addresses to providers, so resources are identified by type and ID.
+func WithResource(ctx context.Context, typeName, id string) context.Context {
+	return context.WithValue(ctx, resourceContextKey{}, resource{typeName: typeName, id: id, structured: true})
+}
+
+// AddResourceRequestHandler adds a handler to a client's handlers that identifies the resource or data source
+// making the client's requests that are not given a context, such as those made by CRUD functions without a context.
+// id is called as each request is built, so requests made after a resource is created include its ID.
+func AddResourceRequestHandler(handlers *request.Handlers, typeName string, id func() string) {
+	handlers.Build.PushFrontNamed(request.NamedHandler{
+		Name: "tf_aws.logging.Resource",
+		Fn: func(r *request.Request) {
+			if r.Context() != aws.BackgroundContext() {
+				return
+			}
+
+			r.SetContext(context.WithValue(r.Context(), resourceContextKey{}, resource{typeName: typeName, id: id()}))
+		},
+	})
+}
+
+// AddRequestHandlers adds the request logging handlers to an AWS SDK for Go session's or client's handlers.
+func AddRequestHandlers(handlers *request.Handlers) {
+	handlers.Send.PushFrontNamed(request.NamedHandler{Name: "tf_aws.logging.Request", Fn: logRequest})
+	handlers.Send.PushBackNamed(request.NamedHandler{Name: "tf_aws.logging.Response", Fn: logResponse})
+	handlers.Complete.PushBackNamed(request.NamedHandler{Name: "tf_aws.logging.Complete", Fn: logComplete})
+}
+
+// logRequest logs the details of each attempt's HTTP request.
+func logRequest(r *request.Request) {
+	if !sdklogging.IsDebugOrHigher() || r.HTTPRequest == nil {
+		return
+	}
+
+	fields := requestFields(r)
+	fields[KeyHTTPMethod] = r.HTTPRequest.Method
+	fields[KeyHTTPURL] = r.HTTPRequest.URL.String()
+	fields[KeyHTTPRequestHeaders] = MaskHeaders(r.HTTPRequest.Header)
+	fields[KeyHTTPRequestBody] = requestBody(r)
+
+	write(r.Context(), "Sending AWS API request", fields)
+}
+
+// logResponse logs the details of each attempt's HTTP response.
+func logResponse(r *request.Request) {
+	if !sdklogging.IsDebugOrHigher() || r.HTTPResponse == nil {
+		return
+	}
+
+	fields := requestFields(r)
+	fields[KeyHTTPStatusCode] = r.HTTPResponse.StatusCode
+	fields[KeyHTTPResponseHeaders] = MaskHeaders(r.HTTPResponse.Header)
+	fields[KeyHTTPResponseBody] = responseBody(r)
+
+	write(r.Context(), "Received AWS API response", fields)
+}
+
+// logComplete logs a summary of the request once it has completed, including any retries.
+func logComplete(r *request.Request) {
+	fields := requestFields(r)
+	fields[KeyDuration] = time.Since(r.Time).Milliseconds()
+
+	if r.HTTPResponse != nil {
+		fields[KeyHTTPStatusCode] = r.HTTPResponse.StatusCode
+	}
+
+	if err, ok := r.Error.(awserr.Error); ok {
+		fields[KeyErrorCode] = err.Code()
+	}
+
+	write(r.Context(), "AWS API request completed", fields)
+}
+
+func requestFields(r *request.Request) map[string]interface{} {
+	fields := map[string]interface{}{
+		KeyService:    r.ClientInfo.ServiceName,
+		KeyRetryCount: r.RetryCount,
+	}
+
+	if r.Operation != nil {
+		fields[KeyOperation] = r.Operation.Name
+	}
+
+	if r.RequestID != "" {
+		fields[KeyRequestID] = r.RequestID
+	}
+
+	return fields
+}
+
+// write writes a debug log entry with the provider's structured logger if ctx carries it,
+// otherwise with the standard library logger.
+func write(ctx context.Context, msg string, fields map[string]interface{}) {
+	if v, ok := ctx.Value(resourceContextKey{}).(resource); ok {
+		fields[KeyResourceType] = v.typeName
+
+		if v.id != "" {
+			fields[KeyResourceID] = v.id
+		}
+
+		if v.structured {
+			tflog.Debug(ctx, msg, fields)
+
+			return
+		}
+	}
+
+	log.Printf("[DEBUG] %s: %s", msg, FormatFields(fields))
+}
+
+// FormatFields formats log entry fields as space-separated key=value pairs, sorted by key.
+func FormatFields(fields map[string]interface{}) string {
+	keys := make([]string, 0, len(fields))
+
+	for k := range fields {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+
+	for _, k := range keys {
+		switch v := fields[k].(type) {
+		case string:
+			pairs = append(pairs, fmt.Sprintf("%s=%q", k, v))
+		default:
+			pairs = append(pairs, fmt.Sprintf("%s=%v", k, v))
+		}
+	}
+
+	return strings.Join(pairs, " ")
+}
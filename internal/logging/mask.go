@@ -0,0 +1,326 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+const (
+	maskedValue = "***"
+
+	// maxBodyLength is the maximum length of a request or response body that is logged.
+	maxBodyLength = 64 * 1024
+)
+
+// sensitiveHeaders are the canonical names of HTTP headers whose values are masked.
+var sensitiveHeaders = map[string]struct{}{
+	"Authorization":        {},
+	"Cookie":               {},
+	"Set-Cookie":           {},
+	"X-Amz-Security-Token": {},
+	"X-Amz-Server-Side-Encryption-Customer-Key":             {},
+	"X-Amz-Copy-Source-Server-Side-Encryption-Customer-Key": {},
+}
+
+// sensitiveBodyFields are the lower-cased names of request and response body fields whose values are masked.
+var sensitiveBodyFields = map[string]struct{}{
+	"accesskey":          {},
+	"authtoken":          {},
+	"clientsecret":       {},
+	"masteruserpassword": {},
+	"newpassword":        {},
+	"oldpassword":        {},
+	"passphrase":         {},
+	"password":           {},
+	"plaintext":          {},
+	"presharedkey":       {},
+	"privatekey":         {},
+	"secretaccesskey":    {},
+	"secretbinary":       {},
+	"secretkey":          {},
+	"secretstring":       {},
+	"sessiontoken":       {},
+	"sharedsecret":       {},
+	"temporarypassword":  {},
+	"token":              {},
+	"userpassword":       {},
+}
+
+// sensitiveOperationBodyFields returns the lower-cased names of request and response body fields whose values are
+// masked for an operation, in addition to sensitiveBodyFields, e.g. the Value of SSM parameters.
+// Such fields are not sensitive for all operations, e.g. the Value of a tag.
+func sensitiveOperationBodyFields(serviceName, operationName string) map[string]struct{} {
+	switch {
+	case serviceName == "ssm" && strings.Contains(operationName, "Parameter"):
+		return map[string]struct{}{"value": {}}
+	}
+
+	return nil
+}
+
+var xmlElementRegexp = regexp.MustCompile(`(?s)<([A-Za-z][A-Za-z0-9]*)>([^<]*)</([A-Za-z][A-Za-z0-9]*)>`)
+
+// MaskHeaders returns the values of HTTP headers with the values of sensitive headers masked.
+func MaskHeaders(header http.Header) map[string]string {
+	m := make(map[string]string, len(header))
+
+	for k, v := range header {
+		if _, ok := sensitiveHeaders[http.CanonicalHeaderKey(k)]; ok {
+			m[k] = maskedValue
+			continue
+		}
+
+		m[k] = strings.Join(v, ", ")
+	}
+
+	return m
+}
+
+// MaskBody returns a request or response body with the values of sensitive fields masked.
+// Only JSON, XML and form-encoded bodies are returned; other bodies are summarized.
+func MaskBody(contentType string, body []byte) string {
+	return maskBody(contentType, body, nil)
+}
+
+// MaskOperationBody returns a request or response body of an AWS API operation with the values of sensitive fields
+// masked, including fields only sensitive for the operation, e.g. the Value of SSM PutParameter and GetParameter.
+func MaskOperationBody(serviceName, operationName, contentType string, body []byte) string {
+	return maskBody(contentType, body, sensitiveOperationBodyFields(serviceName, operationName))
+}
+
+func maskBody(contentType string, body []byte, operationFields map[string]struct{}) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	if len(body) > maxBodyLength {
+		return fmt.Sprintf("[more than %d bytes omitted]", maxBodyLength)
+	}
+
+	mediaType := mediaType(contentType)
+
+	switch {
+	case strings.Contains(mediaType, "json"):
+		return maskJSONBody(body, operationFields)
+	case strings.HasSuffix(mediaType, "xml"):
+		return maskXMLBody(body, operationFields)
+	case mediaType == "application/x-www-form-urlencoded":
+		return maskFormBody(body, operationFields)
+	// Some responses, e.g. EC2 errors, have no Content-Type.
+	case mediaType == "" && bytes.HasPrefix(bytes.TrimSpace(body), []byte("<")):
+		return maskXMLBody(body, operationFields)
+	case mediaType == "" && bytes.HasPrefix(bytes.TrimSpace(body), []byte("{")):
+		return maskJSONBody(body, operationFields)
+	}
+
+	return fmt.Sprintf("[%d bytes of %q omitted]", len(body), mediaType)
+}
+
+func mediaType(contentType string) string {
+	v, _, _ := mime.ParseMediaType(contentType)
+
+	return v
+}
+
+// isLoggableBody returns whether a body of the specified type and length is read for logging.
+// Bodies such as S3 objects and event streams are not read.
+func isLoggableBody(contentType string, contentLength int64) bool {
+	if contentLength > maxBodyLength {
+		return false
+	}
+
+	switch mediaType := mediaType(contentType); {
+	case mediaType == "",
+		strings.Contains(mediaType, "json"),
+		strings.HasSuffix(mediaType, "xml"),
+		mediaType == "application/x-www-form-urlencoded":
+		return true
+	}
+
+	return false
+}
+
+// requestBody returns the masked request body.
+func requestBody(r *request.Request) string {
+	contentType := r.HTTPRequest.Header.Get("Content-Type")
+
+	if r.Body == nil || !aws.IsReaderSeekable(r.Body) || !isLoggableBody(contentType, r.HTTPRequest.ContentLength) {
+		return bodySummary(contentType, r.HTTPRequest.ContentLength)
+	}
+
+	return MaskOperationBody(r.ClientInfo.ServiceName, operationName(r), contentType, readRequestBody(r))
+}
+
+// responseBody returns the masked response body.
+func responseBody(r *request.Request) string {
+	contentType := r.HTTPResponse.Header.Get("Content-Type")
+
+	if !isLoggableBody(contentType, r.HTTPResponse.ContentLength) {
+		return bodySummary(contentType, r.HTTPResponse.ContentLength)
+	}
+
+	return MaskOperationBody(r.ClientInfo.ServiceName, operationName(r), contentType, readResponseBody(r))
+}
+
+func bodySummary(contentType string, contentLength int64) string {
+	if contentLength <= 0 {
+		return fmt.Sprintf("[body of %q omitted]", mediaType(contentType))
+	}
+
+	return fmt.Sprintf("[%d bytes of %q omitted]", contentLength, mediaType(contentType))
+}
+
+func operationName(r *request.Request) string {
+	if r.Operation == nil {
+		return ""
+	}
+
+	return r.Operation.Name
+}
+
+func isSensitiveBodyField(name string, operationFields map[string]struct{}) bool {
+	name = strings.ToLower(name)
+
+	if _, ok := sensitiveBodyFields[name]; ok {
+		return true
+	}
+
+	_, ok := operationFields[name]
+
+	return ok
+}
+
+func maskJSONBody(body []byte, operationFields map[string]struct{}) string {
+	var v interface{}
+
+	if err := json.Unmarshal(body, &v); err != nil {
+		return fmt.Sprintf("[%d bytes of invalid JSON omitted]", len(body))
+	}
+
+	b, err := json.Marshal(maskJSONValue(v, operationFields))
+
+	if err != nil {
+		return fmt.Sprintf("[%d bytes of JSON omitted]", len(body))
+	}
+
+	return string(b)
+}
+
+func maskJSONValue(v interface{}, operationFields map[string]struct{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			if isSensitiveBodyField(k, operationFields) {
+				v[k] = maskedValue
+				continue
+			}
+
+			v[k] = maskJSONValue(e, operationFields)
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = maskJSONValue(e, operationFields)
+		}
+	}
+
+	return v
+}
+
+func maskXMLBody(body []byte, operationFields map[string]struct{}) string {
+	return xmlElementRegexp.ReplaceAllStringFunc(string(body), func(s string) string {
+		m := xmlElementRegexp.FindStringSubmatch(s)
+
+		if m[1] != m[3] || !isSensitiveBodyField(m[1], operationFields) {
+			return s
+		}
+
+		return fmt.Sprintf("<%s>%s</%s>", m[1], maskedValue, m[3])
+	})
+}
+
+func maskFormBody(body []byte, operationFields map[string]struct{}) string {
+	values, err := url.ParseQuery(string(body))
+
+	if err != nil {
+		return fmt.Sprintf("[%d bytes of invalid form data omitted]", len(body))
+	}
+
+	for k := range values {
+		// Query protocol parameter names are paths, e.g. "Attributes.entry.1.value".
+		name := k
+
+		if i := strings.LastIndex(k, "."); i >= 0 {
+			name = k[i+1:]
+		}
+
+		if isSensitiveBodyField(name, operationFields) {
+			values[k] = []string{maskedValue}
+		}
+	}
+
+	return values.Encode()
+}
+
+// readRequestBody returns up to maxBodyLength+1 bytes of the request body, leaving the body's position unchanged.
+func readRequestBody(r *request.Request) []byte {
+	if r.Body == nil {
+		return nil
+	}
+
+	offset, err := r.Body.Seek(0, io.SeekCurrent)
+
+	if err != nil {
+		return nil
+	}
+
+	defer r.Body.Seek(offset, io.SeekStart) //nolint:errcheck
+
+	if _, err := r.Body.Seek(r.BodyStart, io.SeekStart); err != nil {
+		return nil
+	}
+
+	b, err := io.ReadAll(io.LimitReader(r.Body, maxBodyLength+1))
+
+	if err != nil {
+		return nil
+	}
+
+	return b
+}
+
+// readResponseBody returns up to maxBodyLength+1 bytes of the response body.
+// The response body is replaced so that it can still be read in full.
+func readResponseBody(r *request.Request) []byte {
+	if r.HTTPResponse.Body == nil || r.HTTPResponse.Body == http.NoBody {
+		return nil
+	}
+
+	body := r.HTTPResponse.Body
+	b, err := io.ReadAll(io.LimitReader(body, maxBodyLength+1))
+
+	r.HTTPResponse.Body = &readCloser{
+		Reader: io.MultiReader(bytes.NewReader(b), body),
+		Closer: body,
+	}
+
+	if err != nil {
+		return nil
+	}
+
+	return b
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
@@ -0,0 +1,80 @@
+package fwprovider
+
+import (
+	"context"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	fwschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// New returns a new, unconfigured terraform-plugin-framework provider.
+//
+// The framework provider is served alongside the Plugin SDK (primary) provider
+// by a mux server. Both providers share the same provider schema and the
+// framework provider's resources and data sources are handed the
+// *conns.AWSClient configured by the primary provider.
+func New(primary *schema.Provider) provider.Provider {
+	return &fwProvider{
+		primary: primary,
+	}
+}
+
+type fwProvider struct {
+	primary *schema.Provider
+
+	schemaOnce  sync.Once
+	schema      fwschema.Schema
+	schemaDiags diag.Diagnostics
+}
+
+// Metadata returns the provider type name.
+func (p *fwProvider) Metadata(ctx context.Context, request provider.MetadataRequest, response *provider.MetadataResponse) {
+	response.TypeName = "aws"
+}
+
+// Schema returns the schema for this provider's configuration.
+func (p *fwProvider) Schema(ctx context.Context, request provider.SchemaRequest, response *provider.SchemaResponse) {
+	// The mux server requires the provider schemas of the muxed providers to be identical,
+	// so the schema is derived from the primary provider's rather than maintained separately.
+	p.schemaOnce.Do(func() {
+		p.schema, p.schemaDiags = providerSchema(ctx, p.primary)
+	})
+
+	response.Schema = p.schema
+	response.Diagnostics.Append(p.schemaDiags...)
+}
+
+// Configure is called at the beginning of the provider lifecycle, when
+// Terraform sends to the provider the values the user specified in the
+// provider configuration block.
+func (p *fwProvider) Configure(ctx context.Context, request provider.ConfigureRequest, response *provider.ConfigureResponse) {
+	// The mux server configures the muxed providers in order and the primary provider is first.
+	// Share its *conns.AWSClient rather than configuring a second set of AWS API clients.
+	v := p.primary.Meta()
+
+	response.DataSourceData = v
+	response.ResourceData = v
+}
+
+// DataSources returns a slice of functions to instantiate each DataSource
+// implementation.
+//
+// The data source type name is determined by the DataSource implementing
+// the Metadata method. All data sources must have unique names.
+func (p *fwProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	return []func() datasource.DataSource{}
+}
+
+// Resources returns a slice of functions to instantiate each Resource
+// implementation.
+//
+// The resource type name is determined by the Resource implementing
+// the Metadata method. All resources must have unique names.
+func (p *fwProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return []func() resource.Resource{}
+}
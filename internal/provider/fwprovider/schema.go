@@ -0,0 +1,322 @@
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// defaultDeprecationMessage is used for attributes and blocks that the primary provider marks as deprecated.
+// The protocol only carries a flag; the primary provider returns the actual deprecation warning.
+const defaultDeprecationMessage = "Deprecated. See the provider documentation."
+
+// providerSchema returns the framework equivalent of the primary provider's provider schema.
+func providerSchema(ctx context.Context, primary *schema.Provider) (fwschema.Schema, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	output, err := primary.GRPCProvider().GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+
+	if err != nil {
+		diags.AddError("reading Plugin SDK provider schema", err.Error())
+
+		return fwschema.Schema{}, diags
+	}
+
+	for _, d := range output.Diagnostics {
+		if d.Severity == tfprotov5.DiagnosticSeverityError {
+			diags.AddError(d.Summary, d.Detail)
+		}
+	}
+
+	if diags.HasError() {
+		return fwschema.Schema{}, diags
+	}
+
+	if output.Provider == nil || output.Provider.Block == nil {
+		return fwschema.Schema{}, diags
+	}
+
+	block := output.Provider.Block
+	attributes, blocks, err := expandSchemaBlock(block)
+
+	if err != nil {
+		diags.AddError("converting Plugin SDK provider schema", err.Error())
+
+		return fwschema.Schema{}, diags
+	}
+
+	s := fwschema.Schema{
+		Attributes: attributes,
+		Blocks:     blocks,
+	}
+
+	if block.DescriptionKind == tfprotov5.StringKindMarkdown {
+		s.MarkdownDescription = block.Description
+	} else {
+		s.Description = block.Description
+	}
+
+	if block.Deprecated {
+		s.DeprecationMessage = defaultDeprecationMessage
+	}
+
+	return s, diags
+}
+
+func expandSchemaBlock(block *tfprotov5.SchemaBlock) (map[string]fwschema.Attribute, map[string]fwschema.Block, error) {
+	attributes := make(map[string]fwschema.Attribute, len(block.Attributes))
+
+	for _, v := range block.Attributes {
+		attribute, err := expandSchemaAttribute(v)
+
+		if err != nil {
+			return nil, nil, fmt.Errorf("attribute (%s): %w", v.Name, err)
+		}
+
+		attributes[v.Name] = attribute
+	}
+
+	blocks := make(map[string]fwschema.Block, len(block.BlockTypes))
+
+	for _, v := range block.BlockTypes {
+		nestedBlock, err := expandSchemaNestedBlock(v)
+
+		if err != nil {
+			return nil, nil, fmt.Errorf("block (%s): %w", v.TypeName, err)
+		}
+
+		blocks[v.TypeName] = nestedBlock
+	}
+
+	return attributes, blocks, nil
+}
+
+func expandSchemaAttribute(attribute *tfprotov5.SchemaAttribute) (fwschema.Attribute, error) {
+	if attribute.Computed {
+		return nil, fmt.Errorf("provider schema attributes cannot be Computed")
+	}
+
+	var description, markdownDescription, deprecationMessage string
+
+	if attribute.DescriptionKind == tfprotov5.StringKindMarkdown {
+		markdownDescription = attribute.Description
+	} else {
+		description = attribute.Description
+	}
+
+	if attribute.Deprecated {
+		deprecationMessage = defaultDeprecationMessage
+	}
+
+	switch t := attribute.Type; {
+	case t.Is(tftypes.Bool):
+		return fwschema.BoolAttribute{
+			Description:         description,
+			MarkdownDescription: markdownDescription,
+			DeprecationMessage:  deprecationMessage,
+			Optional:            attribute.Optional,
+			Required:            attribute.Required,
+			Sensitive:           attribute.Sensitive,
+		}, nil
+	case t.Is(tftypes.Number):
+		return fwschema.NumberAttribute{
+			Description:         description,
+			MarkdownDescription: markdownDescription,
+			DeprecationMessage:  deprecationMessage,
+			Optional:            attribute.Optional,
+			Required:            attribute.Required,
+			Sensitive:           attribute.Sensitive,
+		}, nil
+	case t.Is(tftypes.String):
+		return fwschema.StringAttribute{
+			Description:         description,
+			MarkdownDescription: markdownDescription,
+			DeprecationMessage:  deprecationMessage,
+			Optional:            attribute.Optional,
+			Required:            attribute.Required,
+			Sensitive:           attribute.Sensitive,
+		}, nil
+	case t.Is(tftypes.List{}):
+		elementType, err := expandType(t.(tftypes.List).ElementType)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return fwschema.ListAttribute{
+			ElementType:         elementType,
+			Description:         description,
+			MarkdownDescription: markdownDescription,
+			DeprecationMessage:  deprecationMessage,
+			Optional:            attribute.Optional,
+			Required:            attribute.Required,
+			Sensitive:           attribute.Sensitive,
+		}, nil
+	case t.Is(tftypes.Map{}):
+		elementType, err := expandType(t.(tftypes.Map).ElementType)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return fwschema.MapAttribute{
+			ElementType:         elementType,
+			Description:         description,
+			MarkdownDescription: markdownDescription,
+			DeprecationMessage:  deprecationMessage,
+			Optional:            attribute.Optional,
+			Required:            attribute.Required,
+			Sensitive:           attribute.Sensitive,
+		}, nil
+	case t.Is(tftypes.Set{}):
+		elementType, err := expandType(t.(tftypes.Set).ElementType)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return fwschema.SetAttribute{
+			ElementType:         elementType,
+			Description:         description,
+			MarkdownDescription: markdownDescription,
+			DeprecationMessage:  deprecationMessage,
+			Optional:            attribute.Optional,
+			Required:            attribute.Required,
+			Sensitive:           attribute.Sensitive,
+		}, nil
+	case t.Is(tftypes.Object{}):
+		objectType, err := expandType(t)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return fwschema.ObjectAttribute{
+			AttributeTypes:      objectType.(types.ObjectType).AttrTypes,
+			Description:         description,
+			MarkdownDescription: markdownDescription,
+			DeprecationMessage:  deprecationMessage,
+			Optional:            attribute.Optional,
+			Required:            attribute.Required,
+			Sensitive:           attribute.Sensitive,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported type: %s", attribute.Type)
+}
+
+func expandSchemaNestedBlock(block *tfprotov5.SchemaNestedBlock) (fwschema.Block, error) {
+	if block.Block == nil {
+		return nil, fmt.Errorf("missing block definition")
+	}
+
+	attributes, blocks, err := expandSchemaBlock(block.Block)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var description, markdownDescription, deprecationMessage string
+
+	if block.Block.DescriptionKind == tfprotov5.StringKindMarkdown {
+		markdownDescription = block.Block.Description
+	} else {
+		description = block.Block.Description
+	}
+
+	if block.Block.Deprecated {
+		deprecationMessage = defaultDeprecationMessage
+	}
+
+	nestedObject := fwschema.NestedBlockObject{
+		Attributes: attributes,
+		Blocks:     blocks,
+	}
+
+	switch block.Nesting {
+	case tfprotov5.SchemaNestedBlockNestingModeList:
+		return fwschema.ListNestedBlock{
+			NestedObject:        nestedObject,
+			Description:         description,
+			MarkdownDescription: markdownDescription,
+			DeprecationMessage:  deprecationMessage,
+		}, nil
+	case tfprotov5.SchemaNestedBlockNestingModeSet:
+		return fwschema.SetNestedBlock{
+			NestedObject:        nestedObject,
+			Description:         description,
+			MarkdownDescription: markdownDescription,
+			DeprecationMessage:  deprecationMessage,
+		}, nil
+	case tfprotov5.SchemaNestedBlockNestingModeSingle:
+		return fwschema.SingleNestedBlock{
+			Attributes:          attributes,
+			Blocks:              blocks,
+			Description:         description,
+			MarkdownDescription: markdownDescription,
+			DeprecationMessage:  deprecationMessage,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported nesting mode: %s", block.Nesting)
+}
+
+func expandType(t tftypes.Type) (attr.Type, error) {
+	switch {
+	case t.Is(tftypes.Bool):
+		return types.BoolType, nil
+	case t.Is(tftypes.Number):
+		return types.NumberType, nil
+	case t.Is(tftypes.String):
+		return types.StringType, nil
+	case t.Is(tftypes.DynamicPseudoType):
+		return types.DynamicType, nil
+	case t.Is(tftypes.List{}):
+		elementType, err := expandType(t.(tftypes.List).ElementType)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return types.ListType{ElemType: elementType}, nil
+	case t.Is(tftypes.Map{}):
+		elementType, err := expandType(t.(tftypes.Map).ElementType)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return types.MapType{ElemType: elementType}, nil
+	case t.Is(tftypes.Set{}):
+		elementType, err := expandType(t.(tftypes.Set).ElementType)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return types.SetType{ElemType: elementType}, nil
+	case t.Is(tftypes.Object{}):
+		attributeTypes := make(map[string]attr.Type)
+
+		for k, v := range t.(tftypes.Object).AttributeTypes {
+			attributeType, err := expandType(v)
+
+			if err != nil {
+				return nil, err
+			}
+
+			attributeTypes[k] = attributeType
+		}
+
+		return types.ObjectType{AttrTypes: attributeTypes}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported type: %s", t)
+}
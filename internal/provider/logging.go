@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"sync"
+
+	awsclient "github.com/aws/aws-sdk-go/aws/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nij4t/terraform-provider-aws/internal/conns"
+	"github.com/nij4t/terraform-provider-aws/internal/logging"
+)
+
+// addResourceLogging identifies the resource or data source in the log entries written for the AWS API
+// requests made by each of the provider's CRUD functions.
+// Context-aware CRUD functions are passed a context identifying the resource.
+// CRUD functions without a context are passed an AWSClient whose service clients identify the resource
+// in requests that are not given a context.
+func addResourceLogging(provider *schema.Provider) {
+	for typeName, r := range provider.DataSourcesMap {
+		r.Read = loggingFunc(typeName, r.Read)
+		r.ReadContext = loggingContextFunc(typeName, r.ReadContext)
+		r.ReadWithoutTimeout = loggingContextFunc(typeName, r.ReadWithoutTimeout)
+	}
+
+	for typeName, r := range provider.ResourcesMap {
+		r.Create = loggingFunc(typeName, r.Create)
+		r.CreateContext = loggingContextFunc(typeName, r.CreateContext)
+		r.CreateWithoutTimeout = loggingContextFunc(typeName, r.CreateWithoutTimeout)
+		r.Read = loggingFunc(typeName, r.Read)
+		r.ReadContext = loggingContextFunc(typeName, r.ReadContext)
+		r.ReadWithoutTimeout = loggingContextFunc(typeName, r.ReadWithoutTimeout)
+		r.Update = loggingFunc(typeName, r.Update)
+		r.UpdateContext = loggingContextFunc(typeName, r.UpdateContext)
+		r.UpdateWithoutTimeout = loggingContextFunc(typeName, r.UpdateWithoutTimeout)
+		r.Delete = loggingFunc(typeName, r.Delete)
+		r.DeleteContext = loggingContextFunc(typeName, r.DeleteContext)
+		r.DeleteWithoutTimeout = loggingContextFunc(typeName, r.DeleteWithoutTimeout)
+	}
+}
+
+func loggingFunc(typeName string, f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	if f == nil {
+		return nil
+	}
+
+	return func(d *schema.ResourceData, meta interface{}) error {
+		if client, ok := meta.(*conns.AWSClient); ok {
+			meta = loggingClient(client, typeName, d)
+		}
+
+		return f(d, meta)
+	}
+}
+
+func loggingContextFunc(typeName string, f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	if f == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		return f(logging.WithResource(ctx, typeName, d.Id()), d, meta)
+	}
+}
+
+var (
+	awsSDKClientType = reflect.TypeOf((*awsclient.Client)(nil))
+
+	serviceClientFieldsOnce sync.Once
+	serviceClientFields     []int
+)
+
+// loggingClient returns a copy of the client whose service clients identify the resource
+// in the log entries written for requests that are not given a context.
+func loggingClient(client *conns.AWSClient, typeName string, d *schema.ResourceData) *conns.AWSClient {
+	c := *client
+	v := reflect.ValueOf(&c).Elem()
+
+	for _, i := range awsClientServiceClientFields(v.Type()) {
+		field := v.Field(i)
+
+		if field.IsNil() || field.Elem().Field(0).IsNil() {
+			continue
+		}
+
+		service := field.Elem()
+		sdkClient := *service.Field(0).Interface().(*awsclient.Client)
+		sdkClient.Handlers = sdkClient.Handlers.Copy()
+		logging.AddResourceRequestHandler(&sdkClient.Handlers, typeName, d.Id)
+
+		copied := reflect.New(service.Type())
+		copied.Elem().Set(service)
+		copied.Elem().Field(0).Set(reflect.ValueOf(&sdkClient))
+		field.Set(copied)
+	}
+
+	return &c
+}
+
+// awsClientServiceClientFields returns the indexes of AWSClient's service client fields,
+// which are pointers to structs embedding *client.Client, e.g. *ec2.EC2.
+func awsClientServiceClientFields(t reflect.Type) []int {
+	serviceClientFieldsOnce.Do(func() {
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+
+			if field.PkgPath != "" || field.Type.Kind() != reflect.Ptr || field.Type.Elem().Kind() != reflect.Struct {
+				continue
+			}
+
+			if service := field.Type.Elem(); service.NumField() == 0 || service.Field(0).Type != awsSDKClientType {
+				continue
+			}
+
+			serviceClientFields = append(serviceClientFields, i)
+		}
+	})
+
+	return serviceClientFields
+}
@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/nij4t/terraform-provider-aws/internal/conns"
+)
+
+func TestAddResourceLogging(t *testing.T) {
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("mock_access_key", "mock_secret_key", ""),
+		Region:      aws.String("us-west-2"), //lintignore:AWSAT003
+	})
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	client := &conns.AWSClient{
+		EC2Conn: ec2.New(sess),
+	}
+
+	p := &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"aws_test": {
+				Create: func(d *schema.ResourceData, meta interface{}) error {
+					conn := meta.(*conns.AWSClient).EC2Conn
+
+					if conn == client.EC2Conn {
+						return fmt.Errorf("got the provider's EC2 client, want a copy")
+					}
+
+					req, _ := conn.DescribeVpcsRequest(&ec2.DescribeVpcsInput{})
+
+					if err := req.Build(); err != nil {
+						return err
+					}
+
+					if req.Context() == aws.BackgroundContext() {
+						return fmt.Errorf("request context does not identify the resource")
+					}
+
+					req, _ = conn.DescribeVpcsRequest(&ec2.DescribeVpcsInput{})
+					req.SetContext(context.TODO())
+
+					if err := req.Build(); err != nil {
+						return err
+					}
+
+					if req.Context() != context.TODO() {
+						return fmt.Errorf("request context replaced")
+					}
+
+					d.SetId("test")
+
+					return nil
+				},
+				Read: func(d *schema.ResourceData, meta interface{}) error {
+					return nil
+				},
+				Delete: func(d *schema.ResourceData, meta interface{}) error {
+					return nil
+				},
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:     schema.TypeString,
+						Required: true,
+						ForceNew: true,
+					},
+				},
+			},
+		},
+	}
+
+	addResourceLogging(p)
+
+	r := p.ResourcesMap["aws_test"]
+
+	if err := r.InternalValidate(nil, true); err != nil {
+		t.Fatalf("error validating resource: %s", err)
+	}
+
+	if r.CreateContext != nil || r.CreateWithoutTimeout != nil {
+		t.Errorf("legacy Create function was converted")
+	}
+
+	diff, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{"name": "test"}), client)
+
+	if err != nil {
+		t.Fatalf("error planning resource: %s", err)
+	}
+
+	state, diags := r.Apply(context.Background(), nil, diff, client)
+
+	if diags.HasError() {
+		t.Fatalf("error creating resource: %v", diags)
+	}
+
+	if state == nil || state.ID != "test" {
+		t.Errorf("got state %v, want resource test", state)
+	}
+
+	req, _ := client.EC2Conn.DescribeVpcsRequest(&ec2.DescribeVpcsInput{})
+
+	if err := req.Build(); err != nil {
+		t.Fatalf("error building request: %s", err)
+	}
+
+	if req.Context() != aws.BackgroundContext() {
+		t.Errorf("provider's EC2 client identifies the resource")
+	}
+}
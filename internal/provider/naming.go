@@ -0,0 +1,285 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nij4t/terraform-provider-aws/internal/conns"
+	"github.com/nij4t/terraform-provider-aws/internal/create"
+)
+
+// namingPolicyAttributes are the attributes of a resource type holding its name and any name prefix.
+type namingPolicyAttributes struct {
+	name       string
+	namePrefix string
+}
+
+// namingPolicyResourceAttributes are the resource types whose configured names are checked against the provider's
+// naming policy. Names generated by create.Name and create.NameWithSuffix follow the policy; these include those of
+// every resource type here with a name prefix attribute.
+// Names that are not free-form, e.g. DNS record and hosted zone names, SSM parameter paths and KMS alias names,
+// are not covered, nor are SNS topic and SQS queue names, whose FIFO suffix follows the policy's suffix.
+var namingPolicyResourceAttributes = map[string]namingPolicyAttributes{
+	"aws_autoscaling_group":             {name: "name", namePrefix: "name_prefix"},
+	"aws_batch_compute_environment":     {name: "compute_environment_name", namePrefix: "compute_environment_name_prefix"},
+	"aws_budgets_budget":                {name: "name", namePrefix: "name_prefix"},
+	"aws_cloudwatch_event_rule":         {name: "name", namePrefix: "name_prefix"},
+	"aws_cloudwatch_log_group":          {name: "name", namePrefix: "name_prefix"},
+	"aws_cloudwatch_metric_stream":      {name: "name", namePrefix: "name_prefix"},
+	"aws_db_event_subscription":         {name: "name", namePrefix: "name_prefix"},
+	"aws_db_instance":                   {name: "identifier", namePrefix: "identifier_prefix"},
+	"aws_dynamodb_table":                {name: "name"},
+	"aws_ecr_repository":                {name: "name"},
+	"aws_eks_cluster":                   {name: "name"},
+	"aws_eks_node_group":                {name: "node_group_name", namePrefix: "node_group_name_prefix"},
+	"aws_iam_policy":                    {name: "name", namePrefix: "name_prefix"},
+	"aws_iam_role":                      {name: "name", namePrefix: "name_prefix"},
+	"aws_key_pair":                      {name: "key_name", namePrefix: "key_name_prefix"},
+	"aws_kinesis_stream":                {name: "name"},
+	"aws_lambda_function":               {name: "function_name"},
+	"aws_launch_configuration":          {name: "name", namePrefix: "name_prefix"},
+	"aws_launch_template":               {name: "name", namePrefix: "name_prefix"},
+	"aws_macie2_classification_job":     {name: "name", namePrefix: "name_prefix"},
+	"aws_macie2_custom_data_identifier": {name: "name", namePrefix: "name_prefix"},
+	"aws_macie2_findings_filter":        {name: "name", namePrefix: "name_prefix"},
+	"aws_rds_cluster":                   {name: "cluster_identifier", namePrefix: "cluster_identifier_prefix"},
+	"aws_s3_bucket":                     {name: "bucket", namePrefix: "bucket_prefix"},
+	"aws_security_group":                {name: "name", namePrefix: "name_prefix"},
+	"aws_signer_signing_profile":        {name: "name", namePrefix: "name_prefix"},
+}
+
+func namingSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Configuration block with a naming convention for the names of the resource types listed in the provider documentation. Other resource types ignore it.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"exclude_resource_types": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Set:         schema.HashString,
+					Description: "Resource types whose configured names are not checked.",
+				},
+				"max_length": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "Maximum length of resource names. Longer generated names are truncated, ending with a hash to keep them unique.",
+				},
+				"pattern": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsValidRegExp,
+					Description:  "Regular expression that configured resource names must match.",
+				},
+				"prefix": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Prefix required of resource names, replacing `terraform-` in generated names.",
+				},
+				"suffix": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Suffix required of resource names, appended to generated names.",
+				},
+			},
+		},
+	}
+}
+
+func expandProviderNaming(l []interface{}) (*create.NamingPolicy, error) {
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+
+	policy := &create.NamingPolicy{}
+	m := l[0].(map[string]interface{})
+
+	if v, ok := m["exclude_resource_types"].(*schema.Set); ok {
+		for _, typeName := range v.List() {
+			policy.ExcludeResourceTypes = append(policy.ExcludeResourceTypes, typeName.(string))
+		}
+	}
+
+	if v, ok := m["max_length"].(int); ok {
+		policy.MaxLength = v
+	}
+
+	if v, ok := m["pattern"].(string); ok && v != "" {
+		pattern, err := regexp.Compile(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("error compiling naming pattern (%s): %w", v, err)
+		}
+
+		policy.Pattern = pattern
+	}
+
+	if v, ok := m["prefix"].(string); ok {
+		policy.Prefix = v
+	}
+
+	if v, ok := m["suffix"].(string); ok {
+		policy.Suffix = v
+	}
+
+	if err := policy.CheckMaxLength(); err != nil {
+		return nil, fmt.Errorf("naming: %w", err)
+	}
+
+	return policy, nil
+}
+
+// addNamingPolicyChecks applies the provider's naming policy to the names of the resource types
+// in namingPolicyResourceAttributes.
+func addNamingPolicyChecks(provider *schema.Provider) {
+	for typeName, attributes := range namingPolicyResourceAttributes {
+		if r, ok := provider.ResourcesMap[typeName]; ok && isConfigurableString(r.Schema[attributes.name]) {
+			addNamingPolicyCheck(typeName, r, attributes)
+		}
+	}
+}
+
+func isConfigurableString(s *schema.Schema) bool {
+	return s != nil && s.Type == schema.TypeString && (s.Required || s.Optional)
+}
+
+// addNamingPolicyCheck checks a resource's configured name and name prefix against the provider's naming policy
+// when planning. Names are only checked when they are changed, so that existing resources are not required to be renamed.
+// A configured name prefix is kept when reading the resource, as the prefix of a name generated by the policy
+// is not recognized by the resource type.
+func addNamingPolicyCheck(typeName string, r *schema.Resource, attributes namingPolicyAttributes) {
+	customizeDiff := r.CustomizeDiff
+	r.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		policy := namingPolicy(meta)
+
+		if v, ok := configuredNameChange(d, attributes.name); ok {
+			if err := policy.CheckName(typeName, v); err != nil {
+				return fmt.Errorf("%s: %w", attributes.name, err)
+			}
+		}
+
+		if v, ok := configuredNameChange(d, attributes.namePrefix); attributes.namePrefix != "" && ok {
+			if err := policy.CheckNamePrefix(typeName, v); err != nil {
+				return fmt.Errorf("%s: %w", attributes.namePrefix, err)
+			}
+		}
+
+		if customizeDiff == nil {
+			return nil
+		}
+
+		return customizeDiff(ctx, d, meta)
+	}
+
+	if attributes.namePrefix == "" {
+		return
+	}
+
+	r.Read = namingPolicyReadFunc(attributes, r.Read)
+	r.ReadContext = namingPolicyReadContextFunc(attributes, r.ReadContext)
+	r.ReadWithoutTimeout = namingPolicyReadContextFunc(attributes, r.ReadWithoutTimeout)
+}
+
+// namingPolicyReadFunc keeps a resource's name prefix when reading it.
+func namingPolicyReadFunc(attributes namingPolicyAttributes, f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	if f == nil {
+		return nil
+	}
+
+	return func(d *schema.ResourceData, meta interface{}) error {
+		policy := namingPolicy(meta)
+
+		if policy == nil {
+			return f(d, meta)
+		}
+
+		namePrefix := d.Get(attributes.namePrefix).(string)
+		err := f(d, meta)
+		setNamePrefix(d, policy, attributes, namePrefix)
+
+		return err
+	}
+}
+
+// namingPolicyReadContextFunc keeps a resource's name prefix when reading it.
+func namingPolicyReadContextFunc(attributes namingPolicyAttributes, f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	if f == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		policy := namingPolicy(meta)
+
+		if policy == nil {
+			return f(ctx, d, meta)
+		}
+
+		namePrefix := d.Get(attributes.namePrefix).(string)
+		diags := f(ctx, d, meta)
+		setNamePrefix(d, policy, attributes, namePrefix)
+
+		return diags
+	}
+}
+
+// setNamePrefix sets a resource's unset name prefix to its prior value, or to the prefix of a name generated by the policy.
+func setNamePrefix(d *schema.ResourceData, policy *create.NamingPolicy, attributes namingPolicyAttributes, namePrefix string) {
+	if attributes.namePrefix == "" || d.Id() == "" || d.Get(attributes.namePrefix).(string) != "" {
+		return
+	}
+
+	name := d.Get(attributes.name).(string)
+
+	if namePrefix == "" || !strings.HasPrefix(name, namePrefix) {
+		v := policy.NamePrefixFromName(name)
+
+		if v == nil {
+			return
+		}
+
+		namePrefix = *v
+	}
+
+	d.Set(attributes.namePrefix, namePrefix)
+}
+
+// namingPolicy returns the provider's naming policy, if any.
+func namingPolicy(meta interface{}) *create.NamingPolicy {
+	if client, ok := meta.(*conns.AWSClient); ok {
+		return client.NamingPolicy
+	}
+
+	return nil
+}
+
+// configuredNameChange returns the planned value of a name attribute if it is known, non-empty and changed.
+func configuredNameChange(d *schema.ResourceDiff, k string) (string, bool) {
+	if k == "" {
+		return "", false
+	}
+
+	if d.Id() != "" && !d.HasChange(k) {
+		return "", false
+	}
+
+	if !d.NewValueKnown(k) {
+		return "", false
+	}
+
+	v, ok := d.GetOk(k)
+
+	if !ok {
+		return "", false
+	}
+
+	return v.(string), true
+}
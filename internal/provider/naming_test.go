@@ -0,0 +1,215 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/nij4t/terraform-provider-aws/internal/conns"
+	"github.com/nij4t/terraform-provider-aws/internal/create"
+)
+
+func TestExpandProviderNaming(t *testing.T) {
+	policy, err := expandProviderNaming(nil)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if policy != nil {
+		t.Errorf("got %#v, want nil policy", policy)
+	}
+
+	policy, err = expandProviderNaming([]interface{}{
+		map[string]interface{}{
+			"exclude_resource_types": schema.NewSet(schema.HashString, []interface{}{"aws_test_excluded"}),
+			"max_length":             32,
+			"pattern":                `^[a-z-]+$`,
+			"prefix":                 "acme-",
+			"suffix":                 "-prod",
+		},
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if policy.Prefix != "acme-" || policy.Suffix != "-prod" || policy.MaxLength != 32 {
+		t.Errorf("unexpected policy: %#v", policy)
+	}
+
+	if policy.Pattern == nil || !policy.Pattern.MatchString("acme-test-prod") {
+		t.Errorf("unexpected pattern: %v", policy.Pattern)
+	}
+
+	if len(policy.ExcludeResourceTypes) != 1 || policy.ExcludeResourceTypes[0] != "aws_test_excluded" {
+		t.Errorf("unexpected excluded resource types: %v", policy.ExcludeResourceTypes)
+	}
+
+	if _, err := expandProviderNaming([]interface{}{map[string]interface{}{"pattern": "("}}); err == nil {
+		t.Error("expected error for invalid pattern")
+	}
+
+	if _, err := expandProviderNaming([]interface{}{map[string]interface{}{"prefix": "acme-", "max_length": 12}}); err == nil {
+		t.Error("expected error for maximum length leaving no room for generated names")
+	}
+}
+
+func TestNamingPolicyResourceAttributes(t *testing.T) {
+	p := Provider()
+
+	for typeName, attributes := range namingPolicyResourceAttributes {
+		r, ok := p.ResourcesMap[typeName]
+
+		if !ok {
+			t.Errorf("%s: resource type not found", typeName)
+			continue
+		}
+
+		for _, k := range []string{attributes.name, attributes.namePrefix} {
+			if k != "" && !isConfigurableString(r.Schema[k]) {
+				t.Errorf("%s: %s is not a configurable string attribute", typeName, k)
+			}
+		}
+	}
+}
+
+func testNamingPolicyResource() *schema.Resource {
+	return &schema.Resource{
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			d.SetId(create.Name(meta.(*conns.AWSClient).NamingPolicy, d.Get("name").(string), d.Get("name_prefix").(string)))
+
+			return nil
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			d.Set("name", d.Id())
+			// As for a generated name with a suffix that the resource type does not recognize.
+			d.Set("name_prefix", create.NamePrefixFromName(d.Id()))
+
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+var testNamingPolicyAttributes = namingPolicyAttributes{
+	name:       "name",
+	namePrefix: "name_prefix",
+}
+
+func TestAddNamingPolicyCheck(t *testing.T) {
+	r := testNamingPolicyResource()
+
+	addNamingPolicyCheck("aws_test_named", r, testNamingPolicyAttributes)
+
+	meta := &conns.AWSClient{
+		NamingPolicy: &create.NamingPolicy{Prefix: "acme-"},
+	}
+
+	testCases := []struct {
+		Name          string
+		Config        map[string]interface{}
+		ExpectedError string
+	}{
+		{
+			Name:   "valid name",
+			Config: map[string]interface{}{"name": "acme-test"},
+		},
+		{
+			Name:          "invalid name",
+			Config:        map[string]interface{}{"name": "test"},
+			ExpectedError: "name: name (test) does not start with the provider naming prefix (acme-)",
+		},
+		{
+			Name:   "valid name prefix",
+			Config: map[string]interface{}{"name_prefix": "acme-test-"},
+		},
+		{
+			Name:          "invalid name prefix",
+			Config:        map[string]interface{}{"name_prefix": "test-"},
+			ExpectedError: "name_prefix: name prefix (test-) does not start with the provider naming prefix (acme-)",
+		},
+		{
+			Name:   "generated name",
+			Config: map[string]interface{}{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			_, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(testCase.Config), meta)
+
+			if testCase.ExpectedError == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), testCase.ExpectedError) {
+				t.Errorf("got error %v, want %q", err, testCase.ExpectedError)
+			}
+		})
+	}
+
+	// Unchanged names of existing resources are not checked.
+	state := &terraform.InstanceState{
+		ID: "test",
+		Attributes: map[string]string{
+			"id":   "test",
+			"name": "test",
+		},
+	}
+
+	if _, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{"name": "test"}), meta); err != nil {
+		t.Errorf("unexpected error for unchanged name: %s", err)
+	}
+
+	// Names are not checked without a naming policy.
+	if _, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{"name": "test"}), &conns.AWSClient{}); err != nil {
+		t.Errorf("unexpected error without a naming policy: %s", err)
+	}
+}
+
+func TestAddNamingPolicyCheckNamePrefix(t *testing.T) {
+	r := testNamingPolicyResource()
+
+	addNamingPolicyCheck("aws_test_named", r, testNamingPolicyAttributes)
+
+	meta := &conns.AWSClient{
+		NamingPolicy: &create.NamingPolicy{Prefix: "acme-", Suffix: "-prod"},
+	}
+
+	d := r.TestResourceData()
+	d.Set("name_prefix", "acme-test-")
+
+	if err := r.Create(d, meta); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := r.Read(d, meta); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if name := d.Get("name").(string); !strings.HasPrefix(name, "acme-test-") || !strings.HasSuffix(name, "-prod") {
+		t.Errorf("got name %s, want name generated from the name prefix", name)
+	}
+
+	if got, want := d.Get("name_prefix").(string), "acme-test-"; got != want {
+		t.Errorf("got name prefix %s, want %s", got, want)
+	}
+}
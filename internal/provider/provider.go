@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/nij4t/terraform-provider-aws/internal/conns"
+	"github.com/nij4t/terraform-provider-aws/internal/flex"
 	"github.com/nij4t/terraform-provider-aws/internal/service/accessanalyzer"
 	"github.com/nij4t/terraform-provider-aws/internal/service/account"
 	"github.com/nij4t/terraform-provider-aws/internal/service/acm"
@@ -190,10 +192,27 @@ func Provider() *schema.Provider {
 			"assume_role": assumeRoleSchema(),
 
 			"shared_credentials_file": {
-				Type:        schema.TypeString,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Default:       "",
+				Description:   descriptions["shared_credentials_file"],
+				Deprecated:    "Use shared_credentials_files instead.",
+				ConflictsWith: []string{"shared_credentials_files"},
+			},
+
+			"shared_credentials_files": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Description:   descriptions["shared_credentials_files"],
+				ConflictsWith: []string{"shared_credentials_file"},
+			},
+
+			"shared_config_files": {
+				Type:        schema.TypeList,
 				Optional:    true,
-				Default:     "",
-				Description: descriptions["shared_credentials_file"],
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions["shared_config_files"],
 			},
 
 			"token": {
@@ -254,12 +273,36 @@ func Provider() *schema.Provider {
 				},
 			},
 
+			"default_timeouts": defaultTimeoutsSchema(),
+
+			"naming": namingSchema(),
+
 			"http_proxy": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Description: descriptions["http_proxy"],
 			},
 
+			"custom_ca_bundle": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["custom_ca_bundle"],
+			},
+
+			"ec2_metadata_service_endpoint": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  descriptions["ec2_metadata_service_endpoint"],
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+
+			"ec2_metadata_service_endpoint_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  descriptions["ec2_metadata_service_endpoint_mode"],
+				ValidateFunc: validation.StringInSlice(conns.EC2MetadataServiceEndpointMode_Values(), false),
+			},
+
 			"endpoints": endpointsSchema(),
 
 			"ignore_tags": {
@@ -294,6 +337,20 @@ func Provider() *schema.Provider {
 				Description: descriptions["insecure"],
 			},
 
+			"policy_validation": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions["policy_validation"],
+			},
+
+			"quota_checks": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions["quota_checks"],
+			},
+
 			"skip_credentials_validation": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -335,11 +392,27 @@ func Provider() *schema.Provider {
 				Default:     false,
 				Description: descriptions["s3_force_path_style"],
 			},
+
+			"use_dualstack_endpoint": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions["use_dualstack_endpoint"],
+			},
+
+			"use_fips_endpoint": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions["use_fips_endpoint"],
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
 			"aws_acm_certificate": acm.DataSourceCertificate(),
 
+			"aws_accessanalyzer_policy_validation": accessanalyzer.DataSourcePolicyValidation(),
+
 			"aws_acmpca_certificate_authority": acmpca.DataSourceCertificateAuthority(),
 			"aws_acmpca_certificate":           acmpca.DataSourceCertificate(),
 
@@ -479,6 +552,7 @@ func Provider() *schema.Provider {
 
 			"aws_ecr_authorization_token": ecr.DataSourceAuthorizationToken(),
 			"aws_ecr_image":               ecr.DataSourceImage(),
+			"aws_ecr_image_scan_findings": ecr.DataSourceImageScanFindings(),
 			"aws_ecr_repository":          ecr.DataSourceRepository(),
 
 			"aws_ecs_cluster":              ecs.DataSourceCluster(),
@@ -532,18 +606,19 @@ func Provider() *schema.Provider {
 
 			"aws_guardduty_detector": guardduty.DataSourceDetector(),
 
-			"aws_iam_account_alias":      iam.DataSourceAccountAlias(),
-			"aws_iam_group":              iam.DataSourceGroup(),
-			"aws_iam_instance_profile":   iam.DataSourceInstanceProfile(),
-			"aws_iam_policy":             iam.DataSourcePolicy(),
-			"aws_iam_policy_document":    iam.DataSourcePolicyDocument(),
-			"aws_iam_role":               iam.DataSourceRole(),
-			"aws_iam_roles":              iam.DataSourceRoles(),
-			"aws_iam_server_certificate": iam.DataSourceServerCertificate(),
-			"aws_iam_session_context":    iam.DataSourceSessionContext(),
-			"aws_iam_user":               iam.DataSourceUser(),
-			"aws_iam_user_ssh_key":       iam.DataSourceUserSSHKey(),
-			"aws_iam_users":              iam.DataSourceUsers(),
+			"aws_iam_account_alias":               iam.DataSourceAccountAlias(),
+			"aws_iam_group":                       iam.DataSourceGroup(),
+			"aws_iam_instance_profile":            iam.DataSourceInstanceProfile(),
+			"aws_iam_policy":                      iam.DataSourcePolicy(),
+			"aws_iam_policy_document":             iam.DataSourcePolicyDocument(),
+			"aws_iam_principal_policy_simulation": iam.DataSourcePrincipalPolicySimulation(),
+			"aws_iam_role":                        iam.DataSourceRole(),
+			"aws_iam_roles":                       iam.DataSourceRoles(),
+			"aws_iam_server_certificate":          iam.DataSourceServerCertificate(),
+			"aws_iam_session_context":             iam.DataSourceSessionContext(),
+			"aws_iam_user":                        iam.DataSourceUser(),
+			"aws_iam_user_ssh_key":                iam.DataSourceUserSSHKey(),
+			"aws_iam_users":                       iam.DataSourceUsers(),
 
 			"aws_identitystore_group": identitystore.DataSourceGroup(),
 			"aws_identitystore_user":  identitystore.DataSourceUser(),
@@ -603,10 +678,13 @@ func Provider() *schema.Provider {
 			"aws_neptune_engine_version":        neptune.DataSourceEngineVersion(),
 			"aws_neptune_orderable_db_instance": neptune.DataSourceOrderableDBInstance(),
 
-			"aws_organizations_delegated_administrators": organizations.DataSourceDelegatedAdministrators(),
-			"aws_organizations_delegated_services":       organizations.DataSourceDelegatedServices(),
-			"aws_organizations_organization":             organizations.DataSourceOrganization(),
-			"aws_organizations_organizational_units":     organizations.DataSourceOrganizationalUnits(),
+			"aws_organizations_aiservices_opt_out_policy_document": organizations.DataSourceAIServicesOptOutPolicyDocument(),
+			"aws_organizations_backup_policy_document":             organizations.DataSourceBackupPolicyDocument(),
+			"aws_organizations_delegated_administrators":           organizations.DataSourceDelegatedAdministrators(),
+			"aws_organizations_delegated_services":                 organizations.DataSourceDelegatedServices(),
+			"aws_organizations_organization":                       organizations.DataSourceOrganization(),
+			"aws_organizations_organizational_units":               organizations.DataSourceOrganizationalUnits(),
+			"aws_organizations_tag_policy_document":                organizations.DataSourceTagPolicyDocument(),
 
 			"aws_outposts_outpost":                outposts.DataSourceOutpost(),
 			"aws_outposts_outpost_instance_type":  outposts.DataSourceOutpostInstanceType(),
@@ -827,9 +905,11 @@ func Provider() *schema.Provider {
 
 			"aws_autoscalingplans_scaling_plan": autoscalingplans.ResourceScalingPlan(),
 
+			"aws_backup_framework":                backup.ResourceFramework(),
 			"aws_backup_global_settings":          backup.ResourceGlobalSettings(),
 			"aws_backup_plan":                     backup.ResourcePlan(),
 			"aws_backup_region_settings":          backup.ResourceRegionSettings(),
+			"aws_backup_report_plan":              backup.ResourceReportPlan(),
 			"aws_backup_selection":                backup.ResourceSelection(),
 			"aws_backup_vault":                    backup.ResourceVault(),
 			"aws_backup_vault_lock_configuration": backup.ResourceVaultLockConfiguration(),
@@ -1009,10 +1089,13 @@ func Provider() *schema.Provider {
 			"aws_directory_service_directory":             ds.ResourceDirectory(),
 			"aws_directory_service_log_subscription":      ds.ResourceLogSubscription(),
 
+			"aws_dynamodb_contributor_insights":          dynamodb.ResourceContributorInsights(),
 			"aws_dynamodb_global_table":                  dynamodb.ResourceGlobalTable(),
 			"aws_dynamodb_kinesis_streaming_destination": dynamodb.ResourceKinesisStreamingDestination(),
 			"aws_dynamodb_table":                         dynamodb.ResourceTable(),
+			"aws_dynamodb_table_export":                  dynamodb.ResourceTableExport(),
 			"aws_dynamodb_table_item":                    dynamodb.ResourceTableItem(),
+			"aws_dynamodb_table_replica":                 dynamodb.ResourceTableReplica(),
 			"aws_dynamodb_tag":                           dynamodb.ResourceTag(),
 
 			"aws_ami":                                             ec2.ResourceAMI(),
@@ -1041,6 +1124,7 @@ func Provider() *schema.Provider {
 			"aws_ec2_client_vpn_route":                            ec2.ResourceClientVPNRoute(),
 			"aws_ec2_fleet":                                       ec2.ResourceFleet(),
 			"aws_ec2_host":                                        ec2.ResourceHost(),
+			"aws_ec2_instance_state":                              ec2.ResourceInstanceState(),
 			"aws_ec2_local_gateway_route":                         ec2.ResourceLocalGatewayRoute(),
 			"aws_ec2_local_gateway_route_table_vpc_association":   ec2.ResourceLocalGatewayRouteTableVPCAssociation(),
 			"aws_ec2_managed_prefix_list":                         ec2.ResourceManagedPrefixList(),
@@ -1114,11 +1198,12 @@ func Provider() *schema.Provider {
 
 			"aws_ecrpublic_repository": ecrpublic.ResourceRepository(),
 
-			"aws_ecs_capacity_provider": ecs.ResourceCapacityProvider(),
-			"aws_ecs_cluster":           ecs.ResourceCluster(),
-			"aws_ecs_service":           ecs.ResourceService(),
-			"aws_ecs_tag":               ecs.ResourceTag(),
-			"aws_ecs_task_definition":   ecs.ResourceTaskDefinition(),
+			"aws_ecs_capacity_provider":          ecs.ResourceCapacityProvider(),
+			"aws_ecs_cluster":                    ecs.ResourceCluster(),
+			"aws_ecs_cluster_capacity_providers": ecs.ResourceClusterCapacityProviders(),
+			"aws_ecs_service":                    ecs.ResourceService(),
+			"aws_ecs_tag":                        ecs.ResourceTag(),
+			"aws_ecs_task_definition":            ecs.ResourceTaskDefinition(),
 
 			"aws_efs_access_point":       efs.ResourceAccessPoint(),
 			"aws_efs_backup_policy":      efs.ResourceBackupPolicy(),
@@ -1129,6 +1214,7 @@ func Provider() *schema.Provider {
 			"aws_eks_addon":                    eks.ResourceAddon(),
 			"aws_eks_cluster":                  eks.ResourceCluster(),
 			"aws_eks_fargate_profile":          eks.ResourceFargateProfile(),
+			"aws_eks_identity_mapping":         eks.ResourceIdentityMapping(),
 			"aws_eks_identity_provider_config": eks.ResourceIdentityProviderConfig(),
 			"aws_eks_node_group":               eks.ResourceNodeGroup(),
 
@@ -1304,8 +1390,10 @@ func Provider() *schema.Provider {
 			"aws_kms_replica_key":          kms.ResourceReplicaKey(),
 
 			"aws_lakeformation_data_lake_settings": lakeformation.ResourceDataLakeSettings(),
+			"aws_lakeformation_lf_tag":             lakeformation.ResourceLFTag(),
 			"aws_lakeformation_permissions":        lakeformation.ResourcePermissions(),
 			"aws_lakeformation_resource":           lakeformation.ResourceResource(),
+			"aws_lakeformation_resource_lf_tags":   lakeformation.ResourceResourceLFTags(),
 
 			"aws_lambda_alias":                          lambda.ResourceAlias(),
 			"aws_lambda_code_signing_config":            lambda.ResourceCodeSigningConfig(),
@@ -1418,25 +1506,28 @@ func Provider() *schema.Provider {
 			"aws_ram_resource_share":          ram.ResourceResourceShare(),
 			"aws_ram_resource_share_accepter": ram.ResourceResourceShareAccepter(),
 
-			"aws_db_cluster_snapshot":           rds.ResourceClusterSnapshot(),
-			"aws_db_event_subscription":         rds.ResourceEventSubscription(),
-			"aws_db_instance":                   rds.ResourceInstance(),
-			"aws_db_instance_role_association":  rds.ResourceInstanceRoleAssociation(),
-			"aws_db_option_group":               rds.ResourceOptionGroup(),
-			"aws_db_parameter_group":            rds.ResourceParameterGroup(),
-			"aws_db_proxy":                      rds.ResourceProxy(),
-			"aws_db_proxy_default_target_group": rds.ResourceProxyDefaultTargetGroup(),
-			"aws_db_proxy_endpoint":             rds.ResourceProxyEndpoint(),
-			"aws_db_proxy_target":               rds.ResourceProxyTarget(),
-			"aws_db_security_group":             rds.ResourceSecurityGroup(),
-			"aws_db_snapshot":                   rds.ResourceSnapshot(),
-			"aws_db_subnet_group":               rds.ResourceSubnetGroup(),
-			"aws_rds_cluster":                   rds.ResourceCluster(),
-			"aws_rds_cluster_endpoint":          rds.ResourceClusterEndpoint(),
-			"aws_rds_cluster_instance":          rds.ResourceClusterInstance(),
-			"aws_rds_cluster_parameter_group":   rds.ResourceClusterParameterGroup(),
-			"aws_rds_cluster_role_association":  rds.ResourceClusterRoleAssociation(),
-			"aws_rds_global_cluster":            rds.ResourceGlobalCluster(),
+			"aws_db_cluster_snapshot":                       rds.ResourceClusterSnapshot(),
+			"aws_db_event_subscription":                     rds.ResourceEventSubscription(),
+			"aws_db_instance":                               rds.ResourceInstance(),
+			"aws_db_instance_automated_backups_replication": rds.ResourceInstanceAutomatedBackupsReplication(),
+			"aws_db_instance_role_association":              rds.ResourceInstanceRoleAssociation(),
+			"aws_db_option_group":                           rds.ResourceOptionGroup(),
+			"aws_db_parameter_group":                        rds.ResourceParameterGroup(),
+			"aws_db_proxy":                                  rds.ResourceProxy(),
+			"aws_db_proxy_default_target_group":             rds.ResourceProxyDefaultTargetGroup(),
+			"aws_db_proxy_endpoint":                         rds.ResourceProxyEndpoint(),
+			"aws_db_proxy_target":                           rds.ResourceProxyTarget(),
+			"aws_db_security_group":                         rds.ResourceSecurityGroup(),
+			"aws_db_snapshot":                               rds.ResourceSnapshot(),
+			"aws_db_subnet_group":                           rds.ResourceSubnetGroup(),
+			"aws_rds_cluster":                               rds.ResourceCluster(),
+			"aws_rds_cluster_activity_stream":               rds.ResourceClusterActivityStream(),
+			"aws_rds_cluster_endpoint":                      rds.ResourceClusterEndpoint(),
+			"aws_rds_cluster_instance":                      rds.ResourceClusterInstance(),
+			"aws_rds_cluster_parameter_group":               rds.ResourceClusterParameterGroup(),
+			"aws_rds_cluster_role_association":              rds.ResourceClusterRoleAssociation(),
+			"aws_rds_export_task":                           rds.ResourceExportTask(),
+			"aws_rds_global_cluster":                        rds.ResourceGlobalCluster(),
 
 			"aws_redshift_cluster":                       redshift.ResourceCluster(),
 			"aws_redshift_event_subscription":            redshift.ResourceEventSubscription(),
@@ -1697,6 +1788,13 @@ func Provider() *schema.Provider {
 		},
 	}
 
+	// Resource logging is added first so that its CRUD functions are passed the AWSClient for the resource's region.
+	addResourceLogging(provider)
+	addRegionOverrides(provider)
+	addNamingPolicyChecks(provider)
+
+	declaredTimeouts := resourceTimeouts(provider)
+
 	provider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
 		terraformVersion := provider.TerraformVersion
 		if terraformVersion == "" {
@@ -1704,6 +1802,11 @@ func Provider() *schema.Provider {
 			// We can therefore assume that if it's missing it's 0.10 or 0.11
 			terraformVersion = "0.11+compatible"
 		}
+
+		if err := setDefaultTimeouts(provider, declaredTimeouts, d.Get("default_timeouts").([]interface{})); err != nil {
+			return nil, err
+		}
+
 		return providerConfigure(d, terraformVersion)
 	}
 
@@ -1729,6 +1832,12 @@ func init() {
 		"shared_credentials_file": "The path to the shared credentials file. If not set\n" +
 			"this defaults to ~/.aws/credentials.",
 
+		"shared_credentials_files": "List of paths to shared credentials files. If not set\n" +
+			"this defaults to [~/.aws/credentials].",
+
+		"shared_config_files": "List of paths to shared config files. If not set\n" +
+			"this defaults to [~/.aws/config].",
+
 		"token": "session token. A session token is only required if you are\n" +
 			"using temporary security credentials.",
 
@@ -1739,11 +1848,26 @@ func init() {
 		"http_proxy": "The address of an HTTP proxy to use when accessing the AWS API. " +
 			"Can also be configured using the `HTTP_PROXY` or `HTTPS_PROXY` environment variables.",
 
+		"custom_ca_bundle": "File containing custom root and intermediate certificates. " +
+			"Can also be configured using the `AWS_CA_BUNDLE` environment variable.",
+
+		"ec2_metadata_service_endpoint": "Address of the EC2 metadata service endpoint to use. " +
+			"Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT` environment variable.",
+
+		"ec2_metadata_service_endpoint_mode": "Protocol to use with EC2 metadata service endpoint." +
+			"Valid values are `IPv4` and `IPv6`. Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE` environment variable.",
+
 		"endpoint": "Use this to override the default service endpoint URL",
 
 		"insecure": "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted, " +
 			"default value is `false`",
 
+		"policy_validation": "Validate IAM policy documents with IAM Access Analyzer during plan. " +
+			"If enabled, the plan fails on ERROR and SECURITY_WARNING findings. If omitted, default value is `false`",
+
+		"quota_checks": "Check planned VPCs, Elastic IPs and Lambda reserved concurrency against Service Quotas during plan. " +
+			"If enabled, the plan fails if a quota would be exceeded. If omitted, default value is `false`",
+
 		"skip_credentials_validation": "Skip the credentials validation via STS API. " +
 			"Used for AWS API implementations that do not have STS available/implemented.",
 
@@ -1763,30 +1887,50 @@ func init() {
 			"i.e., http://s3.amazonaws.com/BUCKET/KEY. By default, the S3 client will\n" +
 			"use virtual hosted bucket addressing when possible\n" +
 			"(http://BUCKET.s3.amazonaws.com/KEY). Specific to the Amazon S3 service.",
+
+		"use_dualstack_endpoint": "Resolve an endpoint with DualStack capability",
+
+		"use_fips_endpoint": "Resolve an endpoint with FIPS capability",
 	}
 }
 
 func providerConfigure(d *schema.ResourceData, terraformVersion string) (interface{}, error) {
+	namingPolicy, err := expandProviderNaming(d.Get("naming").([]interface{}))
+
+	if err != nil {
+		return nil, err
+	}
+
 	config := conns.Config{
-		AccessKey:               d.Get("access_key").(string),
-		SecretKey:               d.Get("secret_key").(string),
-		Profile:                 d.Get("profile").(string),
-		Token:                   d.Get("token").(string),
-		Region:                  d.Get("region").(string),
-		CredsFilename:           d.Get("shared_credentials_file").(string),
-		DefaultTagsConfig:       expandProviderDefaultTags(d.Get("default_tags").([]interface{})),
-		Endpoints:               make(map[string]string),
-		MaxRetries:              d.Get("max_retries").(int),
-		IgnoreTagsConfig:        expandProviderIgnoreTags(d.Get("ignore_tags").([]interface{})),
-		Insecure:                d.Get("insecure").(bool),
-		HTTPProxy:               d.Get("http_proxy").(string),
-		SkipCredsValidation:     d.Get("skip_credentials_validation").(bool),
-		SkipGetEC2Platforms:     d.Get("skip_get_ec2_platforms").(bool),
-		SkipRegionValidation:    d.Get("skip_region_validation").(bool),
-		SkipRequestingAccountId: d.Get("skip_requesting_account_id").(bool),
-		SkipMetadataApiCheck:    d.Get("skip_metadata_api_check").(bool),
-		S3ForcePathStyle:        d.Get("s3_force_path_style").(bool),
-		TerraformVersion:        terraformVersion,
+		AccessKey:                      d.Get("access_key").(string),
+		SecretKey:                      d.Get("secret_key").(string),
+		Profile:                        d.Get("profile").(string),
+		Token:                          d.Get("token").(string),
+		Region:                         d.Get("region").(string),
+		CredsFilename:                  d.Get("shared_credentials_file").(string),
+		CustomCABundle:                 d.Get("custom_ca_bundle").(string),
+		DefaultTagsConfig:              expandProviderDefaultTags(d.Get("default_tags").([]interface{})),
+		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
+		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
+		Endpoints:                      make(map[string]string),
+		MaxRetries:                     d.Get("max_retries").(int),
+		IgnoreTagsConfig:               expandProviderIgnoreTags(d.Get("ignore_tags").([]interface{})),
+		Insecure:                       d.Get("insecure").(bool),
+		HTTPProxy:                      d.Get("http_proxy").(string),
+		NamingPolicy:                   namingPolicy,
+		PolicyValidation:               d.Get("policy_validation").(bool),
+		QuotaChecks:                    d.Get("quota_checks").(bool),
+		SkipCredsValidation:            d.Get("skip_credentials_validation").(bool),
+		SkipGetEC2Platforms:            d.Get("skip_get_ec2_platforms").(bool),
+		SkipRegionValidation:           d.Get("skip_region_validation").(bool),
+		SkipRequestingAccountId:        d.Get("skip_requesting_account_id").(bool),
+		SkipMetadataApiCheck:           d.Get("skip_metadata_api_check").(bool),
+		S3ForcePathStyle:               d.Get("s3_force_path_style").(bool),
+		SharedConfigFiles:              aws.StringValueSlice(flex.ExpandStringList(d.Get("shared_config_files").([]interface{}))),
+		SharedCredentialsFiles:         aws.StringValueSlice(flex.ExpandStringList(d.Get("shared_credentials_files").([]interface{}))),
+		TerraformVersion:               terraformVersion,
+		UseDualStackEndpoint:           d.Get("use_dualstack_endpoint").(bool),
+		UseFIPSEndpoint:                d.Get("use_fips_endpoint").(bool),
 	}
 
 	if l, ok := d.Get("assume_role").([]interface{}); ok && len(l) > 0 && l[0] != nil {
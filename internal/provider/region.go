@@ -0,0 +1,325 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nij4t/terraform-provider-aws/internal/conns"
+)
+
+const (
+	regionAttributeName = "region"
+
+	// regionalImportIDSeparator separates the resource's import ID from the region in which to import it,
+	// e.g. "vpc-12345678@eu-west-1".
+	regionalImportIDSeparator = "@"
+)
+
+var regionRegexp = regexp.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d$`)
+
+// regionalTypeNamePrefixes are prefixes of regional resource and data source types
+// which would otherwise match nonRegionalTypeNamePrefixes.
+var regionalTypeNamePrefixes = []string{
+	"aws_route53_resolver_",
+}
+
+// nonRegionalTypeNamePrefixes are prefixes of resource and data source types
+// whose service APIs are global or that are not associated with a region.
+var nonRegionalTypeNamePrefixes = []string{
+	"aws_account_",
+	"aws_budgets_",
+	"aws_ce_",
+	"aws_cloudfront_",
+	"aws_cur_",
+	"aws_ecrpublic_",
+	"aws_globalaccelerator_",
+	"aws_iam_",
+	"aws_networkmanager_",
+	"aws_organizations_",
+	"aws_route53_",
+	"aws_route53domains_",
+	"aws_route53recoverycontrolconfig_",
+	"aws_route53recoveryreadiness_",
+	"aws_shield_",
+	"aws_waf_",
+}
+
+// nonRegionalTypeNames are resource and data source types
+// whose service APIs are global or that are not associated with a region.
+var nonRegionalTypeNames = map[string]struct{}{
+	"aws_arn":                     {},
+	"aws_billing_service_account": {},
+	"aws_caller_identity":         {},
+	"aws_canonical_user_id":       {},
+	"aws_default_tags":            {},
+	"aws_ip_ranges":               {},
+	"aws_partition":               {},
+	"aws_pricing_product":         {},
+	"aws_region":                  {},
+	"aws_regions":                 {},
+}
+
+// addRegionOverrides adds an optional "region" argument to each of the provider's regional
+// resources and data sources. API calls for a resource or data source are made in that region
+// using an AWSClient derived from the provider configuration, defaulting to the provider's region.
+func addRegionOverrides(provider *schema.Provider) {
+	for typeName, r := range provider.DataSourcesMap {
+		if isRegionalType(typeName, r) {
+			addDataSourceRegionOverride(r)
+		}
+	}
+
+	for typeName, r := range provider.ResourcesMap {
+		if isRegionalType(typeName, r) {
+			addResourceRegionOverride(r)
+		}
+	}
+}
+
+func isRegionalType(typeName string, r *schema.Resource) bool {
+	// Resources and data sources which already have a region attribute handle regions themselves.
+	if _, ok := r.Schema[regionAttributeName]; ok {
+		return false
+	}
+
+	for _, prefix := range regionalTypeNamePrefixes {
+		if strings.HasPrefix(typeName, prefix) {
+			return true
+		}
+	}
+
+	if _, ok := nonRegionalTypeNames[typeName]; ok {
+		return false
+	}
+
+	for _, prefix := range nonRegionalTypeNamePrefixes {
+		if strings.HasPrefix(typeName, prefix) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func addDataSourceRegionOverride(r *schema.Resource) {
+	r.Schema[regionAttributeName] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+	}
+
+	r.Read = regionalFunc(r.Read)
+	r.ReadContext = regionalContextFunc(r.ReadContext)
+	r.ReadWithoutTimeout = regionalContextFunc(r.ReadWithoutTimeout)
+}
+
+func addResourceRegionOverride(r *schema.Resource) {
+	r.Schema[regionAttributeName] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+		ForceNew: true,
+	}
+
+	r.Create = regionalFunc(r.Create)
+	r.CreateContext = regionalContextFunc(r.CreateContext)
+	r.CreateWithoutTimeout = regionalContextFunc(r.CreateWithoutTimeout)
+	r.Read = regionalFunc(r.Read)
+	r.ReadContext = regionalContextFunc(r.ReadContext)
+	r.ReadWithoutTimeout = regionalContextFunc(r.ReadWithoutTimeout)
+	r.Update = regionalFunc(r.Update)
+	r.UpdateContext = regionalContextFunc(r.UpdateContext)
+	r.UpdateWithoutTimeout = regionalContextFunc(r.UpdateWithoutTimeout)
+	r.Delete = regionalFunc(r.Delete)
+	r.DeleteContext = regionalContextFunc(r.DeleteContext)
+	r.DeleteWithoutTimeout = regionalContextFunc(r.DeleteWithoutTimeout)
+
+	customizeDiff := r.CustomizeDiff
+	r.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if err := customizeDiffRegion(d, meta); err != nil {
+			return err
+		}
+
+		if customizeDiff == nil {
+			return nil
+		}
+
+		meta, err := regionalMeta(d, meta)
+
+		if err != nil {
+			return err
+		}
+
+		return customizeDiff(ctx, d, meta)
+	}
+
+	if r.Importer != nil {
+		state, stateContext := r.Importer.State, r.Importer.StateContext
+
+		r.Importer.State = nil
+		r.Importer.StateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			if id, region, ok := parseRegionalImportID(d.Id()); ok {
+				d.SetId(id)
+
+				if err := d.Set(regionAttributeName, region); err != nil {
+					return nil, fmt.Errorf("error setting %s: %w", regionAttributeName, err)
+				}
+			}
+
+			meta, err := regionalMeta(d, meta)
+
+			if err != nil {
+				return nil, err
+			}
+
+			var output []*schema.ResourceData
+
+			switch {
+			case stateContext != nil:
+				output, err = stateContext(ctx, d, meta)
+			case state != nil:
+				output, err = state(d, meta)
+			default:
+				output = []*schema.ResourceData{d}
+			}
+
+			if err != nil {
+				return nil, err
+			}
+
+			for _, v := range output {
+				// Importers may return new ResourceData.
+				if region, ok := v.Get(regionAttributeName).(string); ok && region == "" {
+					if err := setRegion(v, meta); err != nil {
+						return nil, err
+					}
+				}
+			}
+
+			return output, nil
+		}
+	}
+}
+
+// regionalFunc wraps a CRUD function so that it's passed the AWSClient for the resource's region.
+func regionalFunc(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	if f == nil {
+		return nil
+	}
+
+	return func(d *schema.ResourceData, meta interface{}) error {
+		meta, err := regionalMeta(d, meta)
+
+		if err != nil {
+			return err
+		}
+
+		if err := f(d, meta); err != nil {
+			return err
+		}
+
+		return setRegion(d, meta)
+	}
+}
+
+// regionalContextFunc wraps a context-aware CRUD function so that it's passed the AWSClient for the resource's region.
+func regionalContextFunc(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	if f == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		meta, err := regionalMeta(d, meta)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		diags := f(ctx, d, meta)
+
+		if diags.HasError() {
+			return diags
+		}
+
+		if err := setRegion(d, meta); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+
+		return diags
+	}
+}
+
+// regionalMeta returns the AWSClient for the configured region, or the provider's AWSClient if no region is configured.
+func regionalMeta(d interface{ Get(string) interface{} }, meta interface{}) (interface{}, error) {
+	client, ok := meta.(*conns.AWSClient)
+
+	if !ok {
+		return meta, nil
+	}
+
+	region, _ := d.Get(regionAttributeName).(string)
+	client, err := client.RegionalClient(region)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return client, nil
+}
+
+// setRegion records the region of an existing resource.
+func setRegion(d *schema.ResourceData, meta interface{}) error {
+	client, ok := meta.(*conns.AWSClient)
+
+	if !ok || d.Id() == "" {
+		return nil
+	}
+
+	if err := d.Set(regionAttributeName, client.Region); err != nil {
+		return fmt.Errorf("error setting %s: %w", regionAttributeName, err)
+	}
+
+	return nil
+}
+
+// customizeDiffRegion defaults the unconfigured region of a new resource to the provider's region.
+// Existing resources that don't configure a region keep the region they were created in,
+// so changing the provider's region doesn't replace them.
+func customizeDiffRegion(d *schema.ResourceDiff, meta interface{}) error {
+	client, ok := meta.(*conns.AWSClient)
+
+	if !ok {
+		return nil
+	}
+
+	if config := d.GetRawConfig(); config.IsNull() || !config.IsKnown() || !config.GetAttr(regionAttributeName).IsNull() {
+		return nil
+	}
+
+	// Existing resources keep the region in their state. Those in state from before the region attribute
+	// was added have their region set when next read.
+	if d.Id() != "" {
+		return nil
+	}
+
+	return d.SetNew(regionAttributeName, client.Region)
+}
+
+// parseRegionalImportID parses an import ID of the form "<ID>@<region>".
+func parseRegionalImportID(id string) (string, string, bool) {
+	i := strings.LastIndex(id, regionalImportIDSeparator)
+
+	if i < 1 {
+		return "", "", false
+	}
+
+	if region := id[i+1:]; regionRegexp.MatchString(region) {
+		return id[:i], region, true
+	}
+
+	return "", "", false
+}
@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestParseRegionalImportID(t *testing.T) {
+	testCases := []struct {
+		Name           string
+		ID             string
+		ExpectedID     string
+		ExpectedRegion string
+		ExpectedOK     bool
+	}{
+		{
+			Name: "no region",
+			ID:   "vpc-12345678",
+		},
+		{
+			Name:           "region",
+			ID:             "vpc-12345678@eu-west-1", //lintignore:AWSAT003
+			ExpectedID:     "vpc-12345678",
+			ExpectedRegion: "eu-west-1", //lintignore:AWSAT003
+			ExpectedOK:     true,
+		},
+		{
+			Name:           "GovCloud region",
+			ID:             "arn:aws-us-gov:sns:us-gov-west-1:123456789012:test@us-gov-east-1", //lintignore:AWSAT003,AWSAT005
+			ExpectedID:     "arn:aws-us-gov:sns:us-gov-west-1:123456789012:test",               //lintignore:AWSAT003,AWSAT005
+			ExpectedRegion: "us-gov-east-1",                                                    //lintignore:AWSAT003
+			ExpectedOK:     true,
+		},
+		{
+			Name:           "ID containing separator",
+			ID:             "a@b@ap-southeast-2", //lintignore:AWSAT003
+			ExpectedID:     "a@b",
+			ExpectedRegion: "ap-southeast-2", //lintignore:AWSAT003
+			ExpectedOK:     true,
+		},
+		{
+			Name: "email address",
+			ID:   "user@example.com",
+		},
+		{
+			Name: "empty ID",
+			ID:   "@eu-west-1", //lintignore:AWSAT003
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			id, region, ok := parseRegionalImportID(testCase.ID)
+
+			if ok != testCase.ExpectedOK {
+				t.Fatalf("got ok %t, expected %t", ok, testCase.ExpectedOK)
+			}
+
+			if id != testCase.ExpectedID {
+				t.Errorf("got ID %s, expected %s", id, testCase.ExpectedID)
+			}
+
+			if region != testCase.ExpectedRegion {
+				t.Errorf("got region %s, expected %s", region, testCase.ExpectedRegion)
+			}
+		})
+	}
+}
+
+func TestRegionOverrides(t *testing.T) {
+	p := Provider()
+
+	for _, typeName := range []string{"aws_sqs_queue", "aws_vpc", "aws_route53_resolver_endpoint"} {
+		r := p.ResourcesMap[typeName]
+
+		if v, ok := r.Schema[regionAttributeName]; !ok || !v.ForceNew || !v.Optional || !v.Computed {
+			t.Errorf("expected %s to have an Optional, Computed and ForceNew %s argument", typeName, regionAttributeName)
+		}
+	}
+
+	for _, typeName := range []string{"aws_iam_role", "aws_cloudfront_distribution", "aws_route53_zone"} {
+		if _, ok := p.ResourcesMap[typeName].Schema[regionAttributeName]; ok {
+			t.Errorf("expected %s not to have a %s argument", typeName, regionAttributeName)
+		}
+	}
+
+	if _, ok := p.DataSourcesMap["aws_vpc"].Schema[regionAttributeName]; !ok {
+		t.Errorf("expected data source aws_vpc to have a %s argument", regionAttributeName)
+	}
+
+	if _, ok := p.DataSourcesMap["aws_caller_identity"].Schema[regionAttributeName]; ok {
+		t.Errorf("expected data source aws_caller_identity not to have a %s argument", regionAttributeName)
+	}
+}
+
+func TestRegionOverridesGlobalTypes(t *testing.T) {
+	p := Provider()
+
+	// Types of global services, or that are not associated with a region, including types not implemented yet.
+	typeNames := []string{
+		"aws_arn",
+		"aws_budgets_budget",
+		"aws_caller_identity",
+		"aws_cloudfront_distribution",
+		"aws_ecrpublic_authorization_token",
+		"aws_ecrpublic_repository",
+		"aws_globalaccelerator_accelerator",
+		"aws_iam_role",
+		"aws_networkmanager_global_network",
+		"aws_organizations_account",
+		"aws_partition",
+		"aws_pricing_product",
+		"aws_region",
+		"aws_regions",
+		"aws_route53_zone",
+		"aws_route53domains_registered_domain",
+		"aws_shield_protection",
+	}
+
+	for _, typeName := range typeNames {
+		if isRegionalType(typeName, &schema.Resource{Schema: map[string]*schema.Schema{}}) {
+			t.Errorf("expected %s not to be regional", typeName)
+		}
+
+		// Some, such as aws_arn, have their own computed region attribute.
+		if r, ok := p.ResourcesMap[typeName]; ok {
+			if v, ok := r.Schema[regionAttributeName]; ok && v.Optional {
+				t.Errorf("expected resource %s not to have a %s argument", typeName, regionAttributeName)
+			}
+		}
+
+		if r, ok := p.DataSourcesMap[typeName]; ok {
+			if v, ok := r.Schema[regionAttributeName]; ok && v.Optional {
+				t.Errorf("expected data source %s not to have a %s argument", typeName, regionAttributeName)
+			}
+		}
+	}
+}
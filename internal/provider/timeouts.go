@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const defaultTimeoutsResourceTypeKey = "resource_type"
+
+// defaultTimeoutsOperations are the operations whose timeouts can be defaulted by resource type.
+var defaultTimeoutsOperations = []string{
+	schema.TimeoutCreate,
+	schema.TimeoutRead,
+	schema.TimeoutUpdate,
+	schema.TimeoutDelete,
+}
+
+func defaultTimeoutsSchema() *schema.Schema {
+	timeoutsAttributes := map[string]*schema.Schema{
+		defaultTimeoutsResourceTypeKey: {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Resource type to which the timeouts apply, e.g. `aws_db_instance`.",
+		},
+	}
+
+	for _, operation := range defaultTimeoutsOperations {
+		timeoutsAttributes[operation] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  fmt.Sprintf("Default %s timeout, e.g. `90m`.", operation),
+			ValidateFunc: validDuration,
+		}
+	}
+
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Configuration blocks with default operation timeouts for all resources of a type, used unless overridden by a resource's timeouts block.",
+		Elem: &schema.Resource{
+			Schema: timeoutsAttributes,
+		},
+	}
+}
+
+func validDuration(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if value == "" {
+		return
+	}
+
+	if d, err := time.ParseDuration(value); err != nil {
+		errors = append(errors, fmt.Errorf("%q cannot be parsed as a duration: %w", k, err))
+	} else if d <= 0 {
+		errors = append(errors, fmt.Errorf("%q must be greater than zero", k))
+	}
+
+	return
+}
+
+// resourceTimeouts returns the timeouts declared by each of the provider's resources,
+// so that defaults can be reapplied each time the provider is configured.
+func resourceTimeouts(provider *schema.Provider) map[string]*schema.ResourceTimeout {
+	timeouts := make(map[string]*schema.ResourceTimeout)
+
+	for typeName, r := range provider.ResourcesMap {
+		if r.Timeouts != nil {
+			timeouts[typeName] = r.Timeouts
+		}
+	}
+
+	return timeouts
+}
+
+// setDefaultTimeouts overrides the declared timeouts of resources with those of the default_timeouts configuration blocks.
+//
+// The SDK initializes a resource's timeouts from its declared timeouts when planning,
+// and then from any timeouts block in the resource's configuration, so that the defaults
+// are returned by ResourceData.Timeout and used by the waiters given its result.
+func setDefaultTimeouts(provider *schema.Provider, declared map[string]*schema.ResourceTimeout, tfList []interface{}) error {
+	for typeName, timeouts := range declared {
+		provider.ResourcesMap[typeName].Timeouts = timeouts
+	}
+
+	seen := make(map[string]struct{})
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		typeName := tfMap[defaultTimeoutsResourceTypeKey].(string)
+
+		if _, ok := seen[typeName]; ok {
+			return fmt.Errorf("default_timeouts: duplicate resource type %s", typeName)
+		}
+
+		seen[typeName] = struct{}{}
+
+		if _, ok := provider.ResourcesMap[typeName]; !ok {
+			return fmt.Errorf("default_timeouts: unsupported resource type %s", typeName)
+		}
+
+		timeouts, ok := declared[typeName]
+
+		if !ok {
+			return fmt.Errorf("default_timeouts: %s does not support configurable timeouts", typeName)
+		}
+
+		// Copy the declared timeouts, so that they are restored when the provider is reconfigured.
+		defaults := *timeouts
+
+		for _, operation := range defaultTimeoutsOperations {
+			v, ok := tfMap[operation].(string)
+
+			if !ok || v == "" {
+				continue
+			}
+
+			timeout, err := time.ParseDuration(v)
+
+			if err != nil {
+				return fmt.Errorf("default_timeouts: %s %s: %w", typeName, operation, err)
+			}
+
+			var declaredTimeout **time.Duration
+
+			switch operation {
+			case schema.TimeoutCreate:
+				declaredTimeout = &defaults.Create
+			case schema.TimeoutRead:
+				declaredTimeout = &defaults.Read
+			case schema.TimeoutUpdate:
+				declaredTimeout = &defaults.Update
+			case schema.TimeoutDelete:
+				declaredTimeout = &defaults.Delete
+			}
+
+			// As for a resource's timeouts block, only declared timeouts can be configured.
+			if *declaredTimeout == nil {
+				return fmt.Errorf("default_timeouts: %s does not support a configurable %s timeout, supported: %s", typeName, operation, supportedTimeouts(timeouts))
+			}
+
+			*declaredTimeout = schema.DefaultTimeout(timeout)
+		}
+
+		provider.ResourcesMap[typeName].Timeouts = &defaults
+	}
+
+	return nil
+}
+
+func supportedTimeouts(timeouts *schema.ResourceTimeout) string {
+	var operations []string
+
+	for operation, timeout := range map[string]*time.Duration{
+		schema.TimeoutCreate: timeouts.Create,
+		schema.TimeoutRead:   timeouts.Read,
+		schema.TimeoutUpdate: timeouts.Update,
+		schema.TimeoutDelete: timeouts.Delete,
+	} {
+		if timeout != nil {
+			operations = append(operations, operation)
+		}
+	}
+
+	sort.Strings(operations)
+
+	return strings.Join(operations, ", ")
+}
@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testDefaultTimeoutsProvider() *schema.Provider {
+	return &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"aws_test_instance": {
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+				Timeouts: &schema.ResourceTimeout{
+					Create: schema.DefaultTimeout(40 * time.Minute),
+					Delete: schema.DefaultTimeout(60 * time.Minute),
+				},
+			},
+			"aws_test_untimed": {
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestSetDefaultTimeouts(t *testing.T) {
+	p := testDefaultTimeoutsProvider()
+	declared := resourceTimeouts(p)
+
+	err := setDefaultTimeouts(p, declared, []interface{}{
+		map[string]interface{}{
+			"resource_type": "aws_test_instance",
+			"create":        "2h",
+			"read":          "",
+			"update":        "",
+			"delete":        "",
+		},
+	})
+
+	if err != nil {
+		t.Fatalf("error setting default timeouts: %s", err)
+	}
+
+	r := p.ResourcesMap["aws_test_instance"]
+
+	if got, want := *r.Timeouts.Create, 2*time.Hour; got != want {
+		t.Errorf("create timeout = %s, want %s", got, want)
+	}
+
+	if got, want := *r.Timeouts.Delete, 60*time.Minute; got != want {
+		t.Errorf("delete timeout = %s, want %s", got, want)
+	}
+
+	// The default is used when planning a resource without a timeouts block...
+	diff, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "test",
+	}), nil)
+
+	if err != nil {
+		t.Fatalf("error planning: %s", err)
+	}
+
+	timeouts := &schema.ResourceTimeout{}
+
+	if err := timeouts.DiffDecode(diff); err != nil {
+		t.Fatalf("error decoding timeouts: %s", err)
+	}
+
+	if got, want := *timeouts.Create, 2*time.Hour; got != want {
+		t.Errorf("planned create timeout = %s, want %s", got, want)
+	}
+
+	// ...but not a resource with one.
+	diff, err = r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "test",
+		"timeouts": map[string]interface{}{
+			"create": "10m",
+		},
+	}), nil)
+
+	if err != nil {
+		t.Fatalf("error planning: %s", err)
+	}
+
+	if err := timeouts.DiffDecode(diff); err != nil {
+		t.Fatalf("error decoding timeouts: %s", err)
+	}
+
+	if got, want := *timeouts.Create, 10*time.Minute; got != want {
+		t.Errorf("planned create timeout = %s, want %s", got, want)
+	}
+
+	// Reconfiguring without defaults restores the declared timeouts.
+	if err := setDefaultTimeouts(p, declared, nil); err != nil {
+		t.Fatalf("error setting default timeouts: %s", err)
+	}
+
+	if got, want := *p.ResourcesMap["aws_test_instance"].Timeouts.Create, 40*time.Minute; got != want {
+		t.Errorf("create timeout = %s, want %s", got, want)
+	}
+}
+
+func TestSetDefaultTimeoutsErrors(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Config        []interface{}
+		ExpectedError string
+	}{
+		{
+			Name: "unknown resource type",
+			Config: []interface{}{
+				map[string]interface{}{
+					"resource_type": "aws_test_unknown",
+					"create":        "2h",
+				},
+			},
+			ExpectedError: "unsupported resource type aws_test_unknown",
+		},
+		{
+			Name: "resource type without timeouts",
+			Config: []interface{}{
+				map[string]interface{}{
+					"resource_type": "aws_test_untimed",
+					"create":        "2h",
+				},
+			},
+			ExpectedError: "aws_test_untimed does not support configurable timeouts",
+		},
+		{
+			Name: "undeclared operation",
+			Config: []interface{}{
+				map[string]interface{}{
+					"resource_type": "aws_test_instance",
+					"update":        "2h",
+				},
+			},
+			ExpectedError: "aws_test_instance does not support a configurable update timeout, supported: create, delete",
+		},
+		{
+			Name: "duplicate resource type",
+			Config: []interface{}{
+				map[string]interface{}{
+					"resource_type": "aws_test_instance",
+					"create":        "2h",
+				},
+				map[string]interface{}{
+					"resource_type": "aws_test_instance",
+					"delete":        "2h",
+				},
+			},
+			ExpectedError: "duplicate resource type aws_test_instance",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			p := testDefaultTimeoutsProvider()
+
+			err := setDefaultTimeouts(p, resourceTimeouts(p), testCase.Config)
+
+			if err == nil || !strings.Contains(err.Error(), testCase.ExpectedError) {
+				t.Errorf("got error %v, want %q", err, testCase.ExpectedError)
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package accessanalyzer
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nij4t/terraform-provider-aws/internal/conns"
+	"github.com/nij4t/terraform-provider-aws/internal/create"
+	tfiam "github.com/nij4t/terraform-provider-aws/internal/service/iam"
+)
+
+func DataSourcePolicyValidation() *schema.Resource {
+	findingSchema := &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"finding_details": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"issue_code": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"learn_more_link": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Read: dataSourcePolicyValidationRead,
+
+		Schema: map[string]*schema.Schema{
+			"errors": findingSchema,
+			"locale": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(accessanalyzer.Locale_Values(), false),
+			},
+			"policy_document": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"policy_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(accessanalyzer.PolicyType_Values(), false),
+			},
+			"security_warnings": findingSchema,
+			"suggestions":       findingSchema,
+			"warnings":          findingSchema,
+		},
+	}
+}
+
+func dataSourcePolicyValidationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AccessAnalyzerConn
+
+	policyDocument := d.Get("policy_document").(string)
+	policyType := d.Get("policy_type").(string)
+
+	input := &accessanalyzer.ValidatePolicyInput{
+		PolicyDocument: aws.String(policyDocument),
+		PolicyType:     aws.String(policyType),
+	}
+
+	if v, ok := d.GetOk("locale"); ok {
+		input.Locale = aws.String(v.(string))
+	}
+
+	findings, err := tfiam.FindPolicyValidationFindings(conn, input)
+
+	if err != nil {
+		return fmt.Errorf("error validating IAM Access Analyzer policy: %w", err)
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(policyType + policyDocument)))
+
+	findingsByType := map[string][]interface{}{}
+
+	for _, finding := range findings {
+		findingType := aws.StringValue(finding.FindingType)
+		findingsByType[findingType] = append(findingsByType[findingType], flattenValidatePolicyFinding(finding))
+	}
+
+	if err := d.Set("errors", findingsByType[accessanalyzer.ValidatePolicyFindingTypeError]); err != nil {
+		return fmt.Errorf("error setting errors: %w", err)
+	}
+
+	if err := d.Set("security_warnings", findingsByType[accessanalyzer.ValidatePolicyFindingTypeSecurityWarning]); err != nil {
+		return fmt.Errorf("error setting security_warnings: %w", err)
+	}
+
+	if err := d.Set("suggestions", findingsByType[accessanalyzer.ValidatePolicyFindingTypeSuggestion]); err != nil {
+		return fmt.Errorf("error setting suggestions: %w", err)
+	}
+
+	if err := d.Set("warnings", findingsByType[accessanalyzer.ValidatePolicyFindingTypeWarning]); err != nil {
+		return fmt.Errorf("error setting warnings: %w", err)
+	}
+
+	return nil
+}
+
+func flattenValidatePolicyFinding(apiObject *accessanalyzer.ValidatePolicyFinding) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"finding_details": aws.StringValue(apiObject.FindingDetails),
+		"issue_code":      aws.StringValue(apiObject.IssueCode),
+		"learn_more_link": aws.StringValue(apiObject.LearnMoreLink),
+	}
+}
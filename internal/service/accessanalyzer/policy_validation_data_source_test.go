@@ -0,0 +1,70 @@
+package accessanalyzer_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/nij4t/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccAccessAnalyzerPolicyValidationDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_accessanalyzer_policy_validation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, accessanalyzer.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyValidationDataSourceConfig(`"s3:GetObject"`, `"*"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "errors.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "security_warnings.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAccessAnalyzerPolicyValidationDataSource_findings(t *testing.T) {
+	dataSourceName := "data.aws_accessanalyzer_policy_validation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, accessanalyzer.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				// iam:PassRole with a wildcard resource is a SECURITY_WARNING;
+				// an unknown action is an ERROR.
+				Config: testAccPolicyValidationDataSourceConfig(`"iam:PassRole", "s3:NotARealAction"`, `"*"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "errors.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "errors.0.issue_code", "INVALID_ACTION"),
+					resource.TestCheckResourceAttr(dataSourceName, "security_warnings.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "security_warnings.0.issue_code", "PASS_ROLE_WITH_STAR_IN_RESOURCE"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "security_warnings.0.learn_more_link"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPolicyValidationDataSourceConfig(actions, resources string) string {
+	return fmt.Sprintf(`
+data "aws_accessanalyzer_policy_validation" "test" {
+  policy_type = "IDENTITY_POLICY"
+
+  policy_document = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = [%[1]s]
+      Resource = [%[2]s]
+    }]
+  })
+}
+`, actions, resources)
+}
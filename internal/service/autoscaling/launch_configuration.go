@@ -361,7 +361,7 @@ func resourceLaunchConfigurationCreate(d *schema.ResourceData, meta interface{})
 	autoscalingconn := meta.(*conns.AWSClient).AutoScalingConn
 	ec2conn := meta.(*conns.AWSClient).EC2Conn
 
-	lcName := create.Name(d.Get("name").(string), d.Get("name_prefix").(string))
+	lcName := create.Name(meta.(*conns.AWSClient).NamingPolicy, d.Get("name").(string), d.Get("name_prefix").(string))
 
 	createLaunchConfigurationOpts := autoscaling.CreateLaunchConfigurationInput{
 		LaunchConfigurationName: aws.String(lcName),
@@ -0,0 +1,39 @@
+package backup
+
+const (
+	DeploymentStatusCompleted        = "COMPLETED"
+	DeploymentStatusCreateInProgress = "CREATE_IN_PROGRESS"
+	DeploymentStatusDeleteInProgress = "DELETE_IN_PROGRESS"
+	DeploymentStatusFailed           = "FAILED"
+	DeploymentStatusUpdateInProgress = "UPDATE_IN_PROGRESS"
+)
+
+const (
+	ReportDeliveryChannelFormatCSV  = "CSV"
+	ReportDeliveryChannelFormatJSON = "JSON"
+)
+
+func ReportDeliveryChannelFormat_Values() []string {
+	return []string{
+		ReportDeliveryChannelFormatCSV,
+		ReportDeliveryChannelFormatJSON,
+	}
+}
+
+const (
+	ReportSettingReportTemplateBackupJobReport          = "BACKUP_JOB_REPORT"
+	ReportSettingReportTemplateControlComplianceReport  = "CONTROL_COMPLIANCE_REPORT"
+	ReportSettingReportTemplateCopyJobReport            = "COPY_JOB_REPORT"
+	ReportSettingReportTemplateResourceComplianceReport = "RESOURCE_COMPLIANCE_REPORT"
+	ReportSettingReportTemplateRestoreJobReport         = "RESTORE_JOB_REPORT"
+)
+
+func ReportSettingReportTemplate_Values() []string {
+	return []string{
+		ReportSettingReportTemplateBackupJobReport,
+		ReportSettingReportTemplateControlComplianceReport,
+		ReportSettingReportTemplateCopyJobReport,
+		ReportSettingReportTemplateResourceComplianceReport,
+		ReportSettingReportTemplateRestoreJobReport,
+	}
+}
@@ -57,3 +57,53 @@ func FindBackupVaultByName(conn *backup.Backup, name string) (*backup.DescribeBa
 
 	return output, nil
 }
+
+func FindFrameworkByName(conn *backup.Backup, name string) (*backup.DescribeFrameworkOutput, error) {
+	input := &backup.DescribeFrameworkInput{
+		FrameworkName: aws.String(name),
+	}
+
+	output, err := conn.DescribeFramework(input)
+
+	if tfawserr.ErrCodeEquals(err, backup.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindReportPlanByName(conn *backup.Backup, name string) (*backup.ReportPlan, error) {
+	input := &backup.DescribeReportPlanInput{
+		ReportPlanName: aws.String(name),
+	}
+
+	output, err := conn.DescribeReportPlan(input)
+
+	if tfawserr.ErrCodeEquals(err, backup.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ReportPlan == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ReportPlan, nil
+}
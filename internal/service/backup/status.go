@@ -0,0 +1,40 @@
+package backup
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/backup"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/nij4t/terraform-provider-aws/internal/tfresource"
+)
+
+func statusFramework(conn *backup.Backup, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindFrameworkByName(conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.DeploymentStatus), nil
+	}
+}
+
+func statusReportPlan(conn *backup.Backup, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindReportPlanByName(conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.DeploymentStatus), nil
+	}
+}
@@ -232,7 +232,7 @@ func resourceBudgetCreate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("failed unmarshalling budget: %v", err)
 	}
 
-	name := create.Name(d.Get("name").(string), d.Get("name_prefix").(string))
+	name := create.Name(meta.(*conns.AWSClient).NamingPolicy, d.Get("name").(string), d.Get("name_prefix").(string))
 	budget.BudgetName = aws.String(name)
 
 	accountID := d.Get("account_id").(string)
@@ -0,0 +1,110 @@
+package cloudcontrol
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudcontrolapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// CreateResource creates a resource of the given CloudFormation resource type and waits for creation to complete.
+// The resource's identifier is returned whenever it is known, even if waiting fails.
+func CreateResource(ctx context.Context, conn *cloudcontrolapi.CloudControlApi, typeName, desiredState string, timeout time.Duration) (string, error) {
+	input := &cloudcontrolapi.CreateResourceInput{
+		ClientToken:  aws.String(resource.UniqueId()),
+		DesiredState: aws.String(desiredState),
+		TypeName:     aws.String(typeName),
+	}
+
+	output, err := conn.CreateResourceWithContext(ctx, input)
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil || output.ProgressEvent == nil {
+		return "", fmt.Errorf("empty result")
+	}
+
+	id := aws.StringValue(output.ProgressEvent.Identifier)
+
+	progressEvent, err := waitProgressEventOperationStatusSuccess(ctx, conn, aws.StringValue(output.ProgressEvent.RequestToken), timeout)
+
+	// Some resources do not set the identifier until after creation
+	if id == "" && progressEvent != nil {
+		id = aws.StringValue(progressEvent.Identifier)
+	}
+
+	if err != nil {
+		return id, fmt.Errorf("error waiting for creation: %w", err)
+	}
+
+	return id, nil
+}
+
+// UpdateResource updates a resource from one desired state to another and waits for the update to complete.
+func UpdateResource(ctx context.Context, conn *cloudcontrolapi.CloudControlApi, typeName, id, oldDesiredState, newDesiredState string, timeout time.Duration) error {
+	patchDocument, err := patchDocument(oldDesiredState, newDesiredState)
+
+	if err != nil {
+		return fmt.Errorf("error creating JSON Patch: %w", err)
+	}
+
+	input := &cloudcontrolapi.UpdateResourceInput{
+		ClientToken:   aws.String(resource.UniqueId()),
+		Identifier:    aws.String(id),
+		PatchDocument: aws.String(patchDocument),
+		TypeName:      aws.String(typeName),
+	}
+
+	output, err := conn.UpdateResourceWithContext(ctx, input)
+
+	if err != nil {
+		return err
+	}
+
+	if output == nil || output.ProgressEvent == nil {
+		return fmt.Errorf("empty result")
+	}
+
+	if _, err := waitProgressEventOperationStatusSuccess(ctx, conn, aws.StringValue(output.ProgressEvent.RequestToken), timeout); err != nil {
+		return fmt.Errorf("error waiting for update: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteResource deletes a resource and waits for deletion to complete.
+// It is not an error if the resource does not exist.
+func DeleteResource(ctx context.Context, conn *cloudcontrolapi.CloudControlApi, typeName, id string, timeout time.Duration) error {
+	input := &cloudcontrolapi.DeleteResourceInput{
+		ClientToken: aws.String(resource.UniqueId()),
+		Identifier:  aws.String(id),
+		TypeName:    aws.String(typeName),
+	}
+
+	output, err := conn.DeleteResourceWithContext(ctx, input)
+
+	if err != nil {
+		return err
+	}
+
+	if output == nil || output.ProgressEvent == nil {
+		return fmt.Errorf("empty result")
+	}
+
+	progressEvent, err := waitProgressEventOperationStatusSuccess(ctx, conn, aws.StringValue(output.ProgressEvent.RequestToken), timeout)
+
+	if progressEvent != nil && aws.StringValue(progressEvent.ErrorCode) == cloudcontrolapi.HandlerErrorCodeNotFound {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error waiting for deletion: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,476 @@
+package cloudcontrol
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nij4t/terraform-provider-aws/internal/verify"
+)
+
+// Property maps a Terraform attribute of a typed Cloud Control API resource
+// to a property of the resource's CloudFormation resource type.
+//
+// Typed resources are generated from CloudFormation resource schemas by internal/generate/cloudcontrol.
+type Property struct {
+	// Name is the CloudFormation property name.
+	Name string
+
+	// Properties maps the attributes of a nested block to the properties of an object,
+	// or of the objects in an array.
+	Properties map[string]Property
+
+	// Object is set for a nested block holding a single object, rather than an array of objects.
+	Object bool
+
+	// JSON is set for a string attribute holding a JSON-encoded value,
+	// used for free-form objects and values with no typed representation.
+	JSON bool
+
+	// ReadOnly properties are never sent to Cloud Control API.
+	ReadOnly bool
+
+	// WriteOnly properties are never returned by Cloud Control API,
+	// so their configured values are kept in state.
+	WriteOnly bool
+}
+
+// DesiredState returns the JSON-encoded desired state of a resource from its configuration.
+//
+// Attributes that are not configured are omitted, leaving them to the resource type's defaults.
+// Configured zero values, e.g. false, 0 and "", are sent.
+func DesiredState(d *schema.ResourceData, properties map[string]Property) (string, error) {
+	if config := d.GetRawConfig(); !config.IsNull() {
+		return configDesiredState(config, properties)
+	}
+
+	return desiredState(d.Get, nil, properties)
+}
+
+// DesiredStateChange returns the JSON-encoded desired states of a resource before and after an update.
+//
+// The prior desired state holds the attributes that are configured or changed,
+// as unconfigured computed attributes only hold the values read back from Cloud Control API.
+func DesiredStateChange(d *schema.ResourceData, properties map[string]Property) (string, string, error) {
+	config := d.GetRawConfig()
+
+	if config.IsNull() {
+		o, err := desiredState(func(k string) interface{} {
+			v, _ := d.GetChange(k)
+			return v
+		}, nil, properties)
+
+		if err != nil {
+			return "", "", err
+		}
+
+		n, err := desiredState(func(k string) interface{} {
+			_, v := d.GetChange(k)
+			return v
+		}, nil, properties)
+
+		if err != nil {
+			return "", "", err
+		}
+
+		return o, n, nil
+	}
+
+	o, err := desiredState(func(k string) interface{} {
+		v, _ := d.GetChange(k)
+		return v
+	}, func(k string) (bool, bool) {
+		configured := config.Type().HasAttribute(k) && !config.GetAttr(k).IsNull()
+
+		return configured || d.HasChange(k), configured
+	}, properties)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	n, err := configDesiredState(config, properties)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	return o, n, nil
+}
+
+// SetProperties sets a resource's attributes from its JSON-encoded CloudFormation properties.
+func SetProperties(d *schema.ResourceData, properties map[string]Property, propertiesJSON string) error {
+	var values map[string]interface{}
+
+	if err := json.Unmarshal([]byte(propertiesJSON), &values); err != nil {
+		return fmt.Errorf("error decoding properties: %w", err)
+	}
+
+	for k, p := range properties {
+		if p.WriteOnly {
+			continue
+		}
+
+		v, err := flattenValue(values[p.Name], p, d.Get(k))
+
+		if err != nil {
+			return fmt.Errorf("%s: %w", k, err)
+		}
+
+		if err := d.Set(k, v); err != nil {
+			return fmt.Errorf("error setting %s: %w", k, err)
+		}
+	}
+
+	return nil
+}
+
+// desiredState returns the JSON-encoded desired state from attribute values.
+// include, if set, reports whether a top-level attribute is in the desired state and whether its zero value is kept;
+// otherwise unset and zero values are omitted.
+func desiredState(get func(string) interface{}, include func(string) (bool, bool), properties map[string]Property) (string, error) {
+	state, err := expandObject(get, include, properties)
+
+	if err != nil {
+		return "", err
+	}
+
+	return marshalDesiredState(state)
+}
+
+// configDesiredState returns the JSON-encoded desired state from a resource's raw configuration.
+func configDesiredState(config cty.Value, properties map[string]Property) (string, error) {
+	state, err := expandConfigObject(config, properties)
+
+	if err != nil {
+		return "", err
+	}
+
+	return marshalDesiredState(state)
+}
+
+func marshalDesiredState(state map[string]interface{}) (string, error) {
+	b, err := json.Marshal(state)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func expandObject(get func(string) interface{}, include func(string) (bool, bool), properties map[string]Property) (map[string]interface{}, error) {
+	object := map[string]interface{}{}
+
+	for k, p := range properties {
+		if p.ReadOnly {
+			continue
+		}
+
+		keepZero := false
+
+		if include != nil {
+			var ok bool
+
+			if ok, keepZero = include(k); !ok {
+				continue
+			}
+		}
+
+		v, ok, err := expandValue(get(k), p)
+
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", k, err)
+		}
+
+		if ok || (keepZero && v != nil) {
+			object[p.Name] = v
+		}
+	}
+
+	return object, nil
+}
+
+// expandConfigObject converts the configured attributes of an object to properties.
+func expandConfigObject(config cty.Value, properties map[string]Property) (map[string]interface{}, error) {
+	object := map[string]interface{}{}
+
+	for k, p := range properties {
+		if p.ReadOnly || !config.Type().HasAttribute(k) {
+			continue
+		}
+
+		v, ok, err := expandConfigValue(config.GetAttr(k), p)
+
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", k, err)
+		}
+
+		if ok {
+			object[p.Name] = v
+		}
+	}
+
+	return object, nil
+}
+
+// expandConfigValue converts a configured value to a property value, returning false if it is null or unknown.
+func expandConfigValue(v cty.Value, p Property) (interface{}, bool, error) {
+	if v.IsNull() || !v.IsWhollyKnown() {
+		return nil, false, nil
+	}
+
+	if p.JSON {
+		var value interface{}
+
+		if err := json.Unmarshal([]byte(v.AsString()), &value); err != nil {
+			return nil, false, fmt.Errorf("error decoding JSON: %w", err)
+		}
+
+		return value, true, nil
+	}
+
+	if p.Properties != nil {
+		var objects []interface{}
+
+		for it := v.ElementIterator(); it.Next(); {
+			_, item := it.Element()
+
+			if item.IsNull() {
+				continue
+			}
+
+			object, err := expandConfigObject(item, p.Properties)
+
+			if err != nil {
+				return nil, false, err
+			}
+
+			objects = append(objects, object)
+		}
+
+		if len(objects) == 0 {
+			return nil, false, nil
+		}
+
+		if p.Object {
+			return objects[0], true, nil
+		}
+
+		return objects, true, nil
+	}
+
+	value, err := ctyValue(v)
+
+	if err != nil {
+		return nil, false, err
+	}
+
+	return value, true, nil
+}
+
+// ctyValue converts a known configured value to its Go representation.
+func ctyValue(v cty.Value) (interface{}, error) {
+	if v.IsNull() {
+		return nil, nil
+	}
+
+	switch t := v.Type(); {
+	case t == cty.String:
+		return v.AsString(), nil
+	case t == cty.Bool:
+		return v.True(), nil
+	case t == cty.Number:
+		f := v.AsBigFloat()
+
+		if i, accuracy := f.Int64(); accuracy == big.Exact {
+			return int(i), nil
+		}
+
+		value, _ := f.Float64()
+
+		return value, nil
+	case t.IsListType() || t.IsSetType() || t.IsTupleType():
+		values := []interface{}{}
+
+		for it := v.ElementIterator(); it.Next(); {
+			_, e := it.Element()
+			value, err := ctyValue(e)
+
+			if err != nil {
+				return nil, err
+			}
+
+			values = append(values, value)
+		}
+
+		return values, nil
+	case t.IsMapType() || t.IsObjectType():
+		values := map[string]interface{}{}
+
+		for it := v.ElementIterator(); it.Next(); {
+			k, e := it.Element()
+			value, err := ctyValue(e)
+
+			if err != nil {
+				return nil, err
+			}
+
+			values[k.AsString()] = value
+		}
+
+		return values, nil
+	default:
+		return nil, fmt.Errorf("unsupported type %s", t.FriendlyName())
+	}
+}
+
+// expandValue converts an attribute value to a property value, returning false if it is unset or zero.
+func expandValue(v interface{}, p Property) (interface{}, bool, error) {
+	if set, ok := v.(*schema.Set); ok {
+		v = set.List()
+	}
+
+	if p.JSON {
+		s, _ := v.(string)
+
+		if s == "" {
+			return nil, false, nil
+		}
+
+		var value interface{}
+
+		if err := json.Unmarshal([]byte(s), &value); err != nil {
+			return nil, false, fmt.Errorf("error decoding JSON: %w", err)
+		}
+
+		return value, true, nil
+	}
+
+	if p.Properties != nil {
+		var objects []interface{}
+
+		items, _ := v.([]interface{})
+
+		for _, item := range items {
+			m, _ := item.(map[string]interface{})
+
+			object, err := expandObject(func(k string) interface{} { return m[k] }, nil, p.Properties)
+
+			if err != nil {
+				return nil, false, err
+			}
+
+			objects = append(objects, object)
+		}
+
+		if len(objects) == 0 {
+			return nil, false, nil
+		}
+
+		if p.Object {
+			if len(objects[0].(map[string]interface{})) == 0 {
+				return nil, false, nil
+			}
+
+			return objects[0], true, nil
+		}
+
+		return objects, true, nil
+	}
+
+	switch v := v.(type) {
+	case nil:
+		return nil, false, nil
+	case []interface{}:
+		return v, len(v) > 0, nil
+	case map[string]interface{}:
+		return v, len(v) > 0, nil
+	case string:
+		return v, v != "", nil
+	case int:
+		return v, v != 0, nil
+	case float64:
+		return v, v != 0, nil
+	case bool:
+		return v, v, nil
+	default:
+		return nil, false, fmt.Errorf("unsupported type %T", v)
+	}
+}
+
+// flattenValue converts a property value to an attribute value.
+// current is the attribute's value in state, used for write-only nested properties and equivalent JSON.
+func flattenValue(v interface{}, p Property, current interface{}) (interface{}, error) {
+	if set, ok := current.(*schema.Set); ok {
+		current = set.List()
+	}
+
+	if p.JSON {
+		if v == nil {
+			return nil, nil
+		}
+
+		b, err := json.Marshal(v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if s, ok := current.(string); ok && verify.JSONBytesEqual([]byte(s), b) {
+			return s, nil
+		}
+
+		return string(b), nil
+	}
+
+	if p.Properties != nil {
+		var objects []interface{}
+
+		if p.Object {
+			if m, ok := v.(map[string]interface{}); ok {
+				objects = []interface{}{m}
+			}
+		} else {
+			objects, _ = v.([]interface{})
+		}
+
+		currentItems, _ := current.([]interface{})
+		items := make([]interface{}, 0, len(objects))
+
+		for i, object := range objects {
+			m, _ := object.(map[string]interface{})
+
+			var currentItem map[string]interface{}
+
+			if i < len(currentItems) {
+				currentItem, _ = currentItems[i].(map[string]interface{})
+			}
+
+			item := map[string]interface{}{}
+
+			for k, p := range p.Properties {
+				if p.WriteOnly {
+					item[k] = currentItem[k]
+					continue
+				}
+
+				v, err := flattenValue(m[p.Name], p, currentItem[k])
+
+				if err != nil {
+					return nil, fmt.Errorf("%s: %w", k, err)
+				}
+
+				item[k] = v
+			}
+
+			items = append(items, item)
+		}
+
+		return items, nil
+	}
+
+	return v, nil
+}
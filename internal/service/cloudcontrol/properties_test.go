@@ -0,0 +1,201 @@
+package cloudcontrol_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfcloudcontrol "github.com/nij4t/terraform-provider-aws/internal/service/cloudcontrol"
+	"github.com/nij4t/terraform-provider-aws/internal/verify"
+)
+
+var testPropertiesSchema = map[string]*schema.Schema{
+	"arn": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"enabled": {
+		Type:     schema.TypeBool,
+		Optional: true,
+	},
+	"name": {
+		Type:     schema.TypeString,
+		Required: true,
+	},
+	"password": {
+		Type:      schema.TypeString,
+		Optional:  true,
+		Sensitive: true,
+	},
+	"policy": {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
+	"retention": {
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"days": {
+					Type:     schema.TypeInt,
+					Optional: true,
+				},
+			},
+		},
+	},
+	"tags": {
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"value": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	},
+}
+
+var testProperties = map[string]tfcloudcontrol.Property{
+	"arn": {
+		Name:     "Arn",
+		ReadOnly: true,
+	},
+	"enabled": {
+		Name: "Enabled",
+	},
+	"name": {
+		Name: "Name",
+	},
+	"password": {
+		Name:      "Password",
+		WriteOnly: true,
+	},
+	"policy": {
+		Name: "Policy",
+		JSON: true,
+	},
+	"retention": {
+		Name:   "Retention",
+		Object: true,
+		Properties: map[string]tfcloudcontrol.Property{
+			"days": {
+				Name: "Days",
+			},
+		},
+	},
+	"tags": {
+		Name: "Tags",
+		Properties: map[string]tfcloudcontrol.Property{
+			"key": {
+				Name: "Key",
+			},
+			"value": {
+				Name: "Value",
+			},
+		},
+	},
+}
+
+func TestDesiredState(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, testPropertiesSchema, map[string]interface{}{
+		"name":     "test",
+		"password": "secret",
+		"policy":   `{"Version": "2012-10-17"}`,
+		"retention": []interface{}{
+			map[string]interface{}{
+				"days": 7,
+			},
+		},
+		"tags": []interface{}{
+			map[string]interface{}{
+				"key":   "Name",
+				"value": "test",
+			},
+		},
+	})
+
+	got, err := tfcloudcontrol.DesiredState(d, testProperties)
+
+	if err != nil {
+		t.Fatalf("error expanding desired state: %s", err)
+	}
+
+	want := `{"Name":"test","Password":"secret","Policy":{"Version":"2012-10-17"},"Retention":{"Days":7},"Tags":[{"Key":"Name","Value":"test"}]}`
+
+	if !verify.JSONBytesEqual([]byte(got), []byte(want)) {
+		t.Errorf("got desired state %s, want %s", got, want)
+	}
+}
+
+func TestSetProperties(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, testPropertiesSchema, map[string]interface{}{
+		"name":     "test",
+		"password": "secret",
+		"policy":   `{"Version": "2012-10-17"}`,
+	})
+
+	properties := `{"Arn":"arn:aws:example:us-west-2:123456789012:test","Enabled":true,"Name":"test","Policy":{"Version":"2012-10-17"},"Retention":{"Days":7},"Tags":[{"Key":"Name","Value":"test"}]}`
+
+	if err := tfcloudcontrol.SetProperties(d, testProperties, properties); err != nil {
+		t.Fatalf("error setting properties: %s", err)
+	}
+
+	for k, want := range map[string]interface{}{
+		"arn":     "arn:aws:example:us-west-2:123456789012:test",
+		"enabled": true,
+		"name":    "test",
+		// Write-only properties are kept.
+		"password": "secret",
+		// Equivalent JSON is kept.
+		"policy":           `{"Version": "2012-10-17"}`,
+		"retention.0.days": 7,
+		"tags.#":           1,
+	} {
+		if got := d.Get(k); !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: got %#v, want %#v", k, got, want)
+		}
+	}
+}
+
+func TestDesiredStateConfiguredZeroValues(t *testing.T) {
+	resource := &schema.Resource{Schema: testPropertiesSchema}
+	d := resource.Data(&terraform.InstanceState{
+		RawConfig: cty.ObjectVal(map[string]cty.Value{
+			"arn":      cty.NullVal(cty.String),
+			"enabled":  cty.False,
+			"name":     cty.StringVal(""),
+			"password": cty.NullVal(cty.String),
+			"policy":   cty.NullVal(cty.String),
+			"retention": cty.ListVal([]cty.Value{
+				cty.ObjectVal(map[string]cty.Value{
+					"days": cty.NumberIntVal(0),
+				}),
+			}),
+			"tags": cty.SetValEmpty(cty.Object(map[string]cty.Type{
+				"key":   cty.String,
+				"value": cty.String,
+			})),
+		}),
+	})
+
+	got, err := tfcloudcontrol.DesiredState(d, testProperties)
+
+	if err != nil {
+		t.Fatalf("error expanding desired state: %s", err)
+	}
+
+	want := `{"Enabled":false,"Name":"","Retention":{"Days":0}}`
+
+	if !verify.JSONBytesEqual([]byte(got), []byte(want)) {
+		t.Errorf("got desired state %s, want %s", got, want)
+	}
+}
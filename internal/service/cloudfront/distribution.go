@@ -35,6 +35,12 @@ func ResourceDistribution() *schema.Resource {
 		MigrateState:  resourceDistributionMigrateState,
 		SchemaVersion: 1,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DistributionDeployedTimeout),
+			Update: schema.DefaultTimeout(DistributionDeployedTimeout),
+			Delete: schema.DefaultTimeout(DistributionDeployedTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -851,7 +857,7 @@ func resourceDistributionCreate(d *schema.ResourceData, meta interface{}) error
 
 	if d.Get("wait_for_deployment").(bool) {
 		log.Printf("[DEBUG] Waiting until CloudFront Distribution (%s) is deployed", d.Id())
-		if err := DistributionWaitUntilDeployed(d.Id(), meta); err != nil {
+		if err := DistributionWaitUntilDeployed(d.Id(), meta, d.Timeout(schema.TimeoutCreate)); err != nil {
 			return fmt.Errorf("error waiting until CloudFront Distribution (%s) is deployed: %s", d.Id(), err)
 		}
 	}
@@ -953,7 +959,7 @@ func resourceDistributionUpdate(d *schema.ResourceData, meta interface{}) error
 
 	if d.Get("wait_for_deployment").(bool) {
 		log.Printf("[DEBUG] Waiting until CloudFront Distribution (%s) is deployed", d.Id())
-		if err := DistributionWaitUntilDeployed(d.Id(), meta); err != nil {
+		if err := DistributionWaitUntilDeployed(d.Id(), meta, d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return fmt.Errorf("error waiting until CloudFront Distribution (%s) is deployed: %s", d.Id(), err)
 		}
 	}
@@ -1082,7 +1088,7 @@ func resourceDistributionDelete(d *schema.ResourceData, meta interface{}) error
 		}
 
 		log.Printf("[DEBUG] Waiting until CloudFront Distribution (%s) is deployed", d.Id())
-		if err := DistributionWaitUntilDeployed(d.Id(), meta); err != nil {
+		if err := DistributionWaitUntilDeployed(d.Id(), meta, d.Timeout(schema.TimeoutDelete)); err != nil {
 			return fmt.Errorf("error waiting until CloudFront Distribution (%s) is deployed: %s", d.Id(), err)
 		}
 
@@ -1134,15 +1140,18 @@ func resourceDistributionDelete(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
+// DistributionDeployedTimeout is the default time to wait for a distribution to be deployed.
+const DistributionDeployedTimeout = 90 * time.Minute
+
 // resourceAwsCloudFrontWebDistributionWaitUntilDeployed blocks until the
 // distribution is deployed. It currently takes exactly 15 minutes to deploy
 // but that might change in the future.
-func DistributionWaitUntilDeployed(id string, meta interface{}) error {
+func DistributionWaitUntilDeployed(id string, meta interface{}, timeout time.Duration) error {
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"InProgress"},
 		Target:     []string{"Deployed"},
 		Refresh:    resourceWebDistributionStateRefreshFunc(id, meta),
-		Timeout:    90 * time.Minute,
+		Timeout:    timeout,
 		MinTimeout: 15 * time.Second,
 		Delay:      1 * time.Minute,
 	}
@@ -1378,7 +1378,7 @@ func testAccCheckCloudFrontDistributionDisappears(distribution *cloudfront.Distr
 
 func testAccCheckCloudFrontDistributionWaitForDeployment(distribution *cloudfront.Distribution) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		return tfcloudfront.DistributionWaitUntilDeployed(aws.StringValue(distribution.Id), acctest.Provider.Meta())
+		return tfcloudfront.DistributionWaitUntilDeployed(aws.StringValue(distribution.Id), acctest.Provider.Meta(), tfcloudfront.DistributionDeployedTimeout)
 	}
 }
 
@@ -124,7 +124,7 @@ func resourceMetricStreamCreate(ctx context.Context, d *schema.ResourceData, met
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
-	name := create.Name(d.Get("name").(string), d.Get("name_prefix").(string))
+	name := create.Name(meta.(*conns.AWSClient).NamingPolicy, d.Get("name").(string), d.Get("name_prefix").(string))
 
 	params := cloudwatch.PutMetricStreamInput{
 		Name:         aws.String(name),
@@ -7,10 +7,10 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/nij4t/terraform-provider-aws/internal/conns"
+	"github.com/nij4t/terraform-provider-aws/internal/create"
 	tftags "github.com/nij4t/terraform-provider-aws/internal/tags"
 	"github.com/nij4t/terraform-provider-aws/internal/verify"
 )
@@ -71,14 +71,7 @@ func resourceGroupCreate(d *schema.ResourceData, meta interface{}) error {
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
-	var logGroupName string
-	if v, ok := d.GetOk("name"); ok {
-		logGroupName = v.(string)
-	} else if v, ok := d.GetOk("name_prefix"); ok {
-		logGroupName = resource.PrefixedUniqueId(v.(string))
-	} else {
-		logGroupName = resource.UniqueId()
-	}
+	logGroupName := create.Name(meta.(*conns.AWSClient).NamingPolicy, d.Get("name").(string), d.Get("name_prefix").(string))
 
 	log.Printf("[DEBUG] Creating CloudWatch Log Group: %s", logGroupName)
 
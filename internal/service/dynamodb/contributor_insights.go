@@ -0,0 +1,180 @@
+package dynamodb
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nij4t/terraform-provider-aws/internal/conns"
+)
+
+func ResourceContributorInsights() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceContributorInsightsCreate,
+		Read:   resourceContributorInsightsRead,
+		Delete: resourceContributorInsightsDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"index_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"table_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceContributorInsightsCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DynamoDBConn
+
+	tableName := d.Get("table_name").(string)
+	input := &dynamodb.UpdateContributorInsightsInput{
+		ContributorInsightsAction: aws.String(dynamodb.ContributorInsightsActionEnable),
+		TableName:                 aws.String(tableName),
+	}
+
+	var indexName string
+	if v, ok := d.GetOk("index_name"); ok {
+		indexName = v.(string)
+		input.IndexName = aws.String(indexName)
+	}
+
+	log.Printf("[DEBUG] Enabling DynamoDB Contributor Insights: %s", input)
+	_, err := conn.UpdateContributorInsights(input)
+
+	if err != nil {
+		return fmt.Errorf("error enabling DynamoDB Table (%s) Contributor Insights: %w", tableName, err)
+	}
+
+	d.SetId(ContributorInsightsCreateID(meta.(*conns.AWSClient), tableName, indexName))
+
+	if _, err := waitDynamoDBContributorInsightsEnabled(conn, tableName, indexName); err != nil {
+		return fmt.Errorf("error waiting for DynamoDB Contributor Insights (%s) to be enabled: %w", d.Id(), err)
+	}
+
+	return resourceContributorInsightsRead(d, meta)
+}
+
+func resourceContributorInsightsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DynamoDBConn
+
+	tableName, indexName, err := ContributorInsightsParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := FindDynamoDBContributorInsights(conn, tableName, indexName)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] DynamoDB Contributor Insights (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading DynamoDB Contributor Insights (%s): %w", d.Id(), err)
+	}
+
+	if output == nil || aws.StringValue(output.ContributorInsightsStatus) == dynamodb.ContributorInsightsStatusDisabled {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading DynamoDB Contributor Insights (%s): not enabled after creation", d.Id())
+		}
+
+		log.Printf("[WARN] DynamoDB Contributor Insights (%s) not enabled, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("index_name", output.IndexName)
+	d.Set("table_name", output.TableName)
+
+	return nil
+}
+
+func resourceContributorInsightsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DynamoDBConn
+
+	tableName, indexName, err := ContributorInsightsParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &dynamodb.UpdateContributorInsightsInput{
+		ContributorInsightsAction: aws.String(dynamodb.ContributorInsightsActionDisable),
+		TableName:                 aws.String(tableName),
+	}
+
+	if indexName != "" {
+		input.IndexName = aws.String(indexName)
+	}
+
+	log.Printf("[DEBUG] Disabling DynamoDB Contributor Insights: %s", d.Id())
+	_, err = conn.UpdateContributorInsights(input)
+
+	if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error disabling DynamoDB Contributor Insights (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitDynamoDBContributorInsightsDisabled(conn, tableName, indexName); err != nil {
+		return fmt.Errorf("error waiting for DynamoDB Contributor Insights (%s) to be disabled: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// ContributorInsightsCreateID returns the ARN of the table, or of the global
+// secondary index, that Contributor Insights is enabled for.
+func ContributorInsightsCreateID(client *conns.AWSClient, tableName, indexName string) string {
+	resource := fmt.Sprintf("table/%s", tableName)
+
+	if indexName != "" {
+		resource = fmt.Sprintf("%s/index/%s", resource, indexName)
+	}
+
+	return arn.ARN{
+		Partition: client.Partition,
+		Service:   dynamodb.EndpointsID,
+		Region:    client.Region,
+		AccountID: client.AccountID,
+		Resource:  resource,
+	}.String()
+}
+
+func ContributorInsightsParseID(id string) (string, string, error) {
+	resourceARN, err := arn.Parse(id)
+
+	if err != nil {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected table or index ARN: %w", id, err)
+	}
+
+	parts := strings.Split(resourceARN.Resource, "/")
+
+	switch {
+	case len(parts) == 2 && parts[0] == "table" && parts[1] != "":
+		return parts[1], "", nil
+	case len(parts) == 4 && parts[0] == "table" && parts[1] != "" && parts[2] == "index" && parts[3] != "":
+		return parts[1], parts[3], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format of ID (%s), expected table or index ARN", id)
+}
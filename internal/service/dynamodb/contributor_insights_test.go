@@ -0,0 +1,267 @@
+package dynamodb_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/nij4t/terraform-provider-aws/internal/acctest"
+	"github.com/nij4t/terraform-provider-aws/internal/conns"
+	tfdynamodb "github.com/nij4t/terraform-provider-aws/internal/service/dynamodb"
+)
+
+func TestContributorInsightsParseID(t *testing.T) {
+	testCases := []struct {
+		ID            string
+		TableName     string
+		IndexName     string
+		ExpectedError bool
+	}{
+		{
+			ID:            "",
+			ExpectedError: true,
+		},
+		{
+			ID:            "example",
+			ExpectedError: true,
+		},
+		{
+			ID:            "arn:aws:dynamodb:us-west-2:123456789012:table/",
+			ExpectedError: true,
+		},
+		{
+			ID:            "arn:aws:dynamodb:us-west-2:123456789012:table/example/index/",
+			ExpectedError: true,
+		},
+		{
+			ID:            "arn:aws:dynamodb:us-west-2:123456789012:table/example/stream/2021-01-01T00:00:00.000",
+			ExpectedError: true,
+		},
+		{
+			ID:        "arn:aws:dynamodb:us-west-2:123456789012:table/example",
+			TableName: "example",
+		},
+		{
+			ID:        "arn:aws:dynamodb:us-west-2:123456789012:table/example/index/example-index",
+			TableName: "example",
+			IndexName: "example-index",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.ID, func(t *testing.T) {
+			tableName, indexName, err := tfdynamodb.ContributorInsightsParseID(testCase.ID)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if tableName != testCase.TableName {
+				t.Errorf("got table name %s, expected %s", tableName, testCase.TableName)
+			}
+
+			if indexName != testCase.IndexName {
+				t.Errorf("got index name %s, expected %s", indexName, testCase.IndexName)
+			}
+		})
+	}
+}
+
+func TestAccDynamoDBContributorInsights_basic(t *testing.T) {
+	var conf dynamodb.DescribeContributorInsightsOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_contributor_insights.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckContributorInsightsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContributorInsightsConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContributorInsightsExists(resourceName, &conf),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "id", "dynamodb", fmt.Sprintf("table/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "table_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "index_name", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBContributorInsights_globalSecondaryIndex(t *testing.T) {
+	var conf dynamodb.DescribeContributorInsightsOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_contributor_insights.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckContributorInsightsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContributorInsightsIndexConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContributorInsightsExists(resourceName, &conf),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "id", "dynamodb", fmt.Sprintf("table/%[1]s/index/%[1]s-index", rName)),
+					resource.TestCheckResourceAttr(resourceName, "table_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "index_name", fmt.Sprintf("%s-index", rName)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBContributorInsights_disappears(t *testing.T) {
+	var conf dynamodb.DescribeContributorInsightsOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_contributor_insights.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckContributorInsightsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContributorInsightsConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContributorInsightsExists(resourceName, &conf),
+					acctest.CheckResourceDisappears(acctest.Provider, tfdynamodb.ResourceContributorInsights(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckContributorInsightsDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_dynamodb_contributor_insights" {
+			continue
+		}
+
+		tableName, indexName, err := tfdynamodb.ContributorInsightsParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		output, err := tfdynamodb.FindDynamoDBContributorInsights(conn, tableName, indexName)
+
+		if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if status := aws.StringValue(output.ContributorInsightsStatus); status != dynamodb.ContributorInsightsStatusDisabled {
+			return fmt.Errorf("DynamoDB Contributor Insights (%s) still %s", rs.Primary.ID, status)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckContributorInsightsExists(n string, v *dynamodb.DescribeContributorInsightsOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DynamoDB Contributor Insights ID is set")
+		}
+
+		tableName, indexName, err := tfdynamodb.ContributorInsightsParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn
+
+		output, err := tfdynamodb.FindDynamoDBContributorInsights(conn, tableName, indexName)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccContributorInsightsBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name           = %[1]q
+  read_capacity  = 2
+  write_capacity = 2
+  hash_key       = "TestTableHashKey"
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+
+  attribute {
+    name = "TestTableGSIKey"
+    type = "S"
+  }
+
+  global_secondary_index {
+    name            = "%[1]s-index"
+    hash_key        = "TestTableGSIKey"
+    projection_type = "KEYS_ONLY"
+    read_capacity   = 1
+    write_capacity  = 1
+  }
+}
+`, rName)
+}
+
+func testAccContributorInsightsConfig(rName string) string {
+	return acctest.ConfigCompose(testAccContributorInsightsBaseConfig(rName), `
+resource "aws_dynamodb_contributor_insights" "test" {
+  table_name = aws_dynamodb_table.test.name
+}
+`)
+}
+
+func testAccContributorInsightsIndexConfig(rName string) string {
+	return acctest.ConfigCompose(testAccContributorInsightsBaseConfig(rName), fmt.Sprintf(`
+resource "aws_dynamodb_contributor_insights" "test" {
+  table_name = aws_dynamodb_table.test.name
+  index_name = "%[1]s-index"
+}
+`, rName))
+}
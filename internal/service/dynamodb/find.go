@@ -119,3 +119,60 @@ func FindDynamoDBTTLRDescriptionByTableName(conn *dynamodb.DynamoDB, tableName s
 
 	return output.TimeToLiveDescription, nil
 }
+
+func FindDynamoDBContributorInsights(conn *dynamodb.DynamoDB, tableName, indexName string) (*dynamodb.DescribeContributorInsightsOutput, error) {
+	input := &dynamodb.DescribeContributorInsightsInput{
+		TableName: aws.String(tableName),
+	}
+
+	if indexName != "" {
+		input.IndexName = aws.String(indexName)
+	}
+
+	output, err := conn.DescribeContributorInsights(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindDynamoDBTableExportByARN(conn *dynamodb.DynamoDB, arn string) (*dynamodb.ExportDescription, error) {
+	input := &dynamodb.DescribeExportInput{
+		ExportArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeExport(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.ExportDescription, nil
+}
+
+// FindDynamoDBTableNames returns the names of all tables.
+func FindDynamoDBTableNames(ctx context.Context, conn *dynamodb.DynamoDB) ([]string, error) {
+	var names []string
+
+	err := conn.ListTablesPagesWithContext(ctx, &dynamodb.ListTablesInput{}, func(page *dynamodb.ListTablesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		names = append(names, aws.StringValueSlice(page.TableNames)...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return names, nil
+}
@@ -184,3 +184,43 @@ func statusDynamoDBTableSES(conn *dynamodb.DynamoDB, tableName string) resource.
 		return table, aws.StringValue(table.SSEDescription.Status), nil
 	}
 }
+
+func statusDynamoDBContributorInsights(conn *dynamodb.DynamoDB, tableName, indexName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDynamoDBContributorInsights(conn, tableName, indexName)
+
+		if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil {
+			return nil, "", nil
+		}
+
+		return output, aws.StringValue(output.ContributorInsightsStatus), nil
+	}
+}
+
+func statusDynamoDBTableExport(conn *dynamodb.DynamoDB, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		export, err := FindDynamoDBTableExportByARN(conn, arn)
+
+		if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeExportNotFoundException) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if export == nil {
+			return nil, "", nil
+		}
+
+		return export, aws.StringValue(export.ExportStatus), nil
+	}
+}
@@ -0,0 +1,222 @@
+package dynamodb
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nij4t/terraform-provider-aws/internal/conns"
+	"github.com/nij4t/terraform-provider-aws/internal/verify"
+)
+
+func ResourceTableExport() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTableExportCreate,
+		Read:   resourceTableExportRead,
+		Delete: resourceTableExportDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(tableExportCreatedTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"billed_size_in_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"end_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"export_format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      dynamodb.ExportFormatDynamodbJson,
+				ValidateFunc: validation.StringInSlice(dynamodb.ExportFormat_Values(), false),
+			},
+			"export_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"export_time": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Computed:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: verify.SuppressEquivalentRFC3339Time,
+			},
+			"item_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"manifest_files_s3_key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"s3_bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"s3_bucket_owner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"s3_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"s3_sse_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(dynamodb.S3SseAlgorithm_Values(), false),
+			},
+			"s3_sse_kms_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"start_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"table_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceTableExportCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DynamoDBConn
+
+	tableARN := d.Get("table_arn").(string)
+	input := &dynamodb.ExportTableToPointInTimeInput{
+		ClientToken:  aws.String(resource.UniqueId()),
+		ExportFormat: aws.String(d.Get("export_format").(string)),
+		S3Bucket:     aws.String(d.Get("s3_bucket").(string)),
+		TableArn:     aws.String(tableARN),
+	}
+
+	if v, ok := d.GetOk("export_time"); ok {
+		v, _ := time.Parse(time.RFC3339, v.(string))
+		input.ExportTime = aws.Time(v)
+	}
+
+	if v, ok := d.GetOk("s3_bucket_owner"); ok {
+		input.S3BucketOwner = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("s3_prefix"); ok {
+		input.S3Prefix = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("s3_sse_algorithm"); ok {
+		input.S3SseAlgorithm = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("s3_sse_kms_key_id"); ok {
+		input.S3SseKmsKeyId = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating DynamoDB Table Export: %s", input)
+	output, err := conn.ExportTableToPointInTime(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating DynamoDB Table (%s) export: %w", tableARN, err)
+	}
+
+	d.SetId(aws.StringValue(output.ExportDescription.ExportArn))
+
+	if _, err := waitDynamoDBTableExportCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for DynamoDB Table Export (%s) to complete: %w", d.Id(), err)
+	}
+
+	return resourceTableExportRead(d, meta)
+}
+
+func resourceTableExportRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DynamoDBConn
+
+	export, err := FindDynamoDBTableExportByARN(conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeExportNotFoundException) {
+		log.Printf("[WARN] DynamoDB Table Export (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading DynamoDB Table Export (%s): %w", d.Id(), err)
+	}
+
+	if export == nil {
+		return fmt.Errorf("error reading DynamoDB Table Export (%s): empty output", d.Id())
+	}
+
+	d.Set("arn", export.ExportArn)
+	d.Set("billed_size_in_bytes", export.BilledSizeBytes)
+	d.Set("export_format", export.ExportFormat)
+	d.Set("export_status", export.ExportStatus)
+	d.Set("item_count", export.ItemCount)
+	d.Set("manifest_files_s3_key", export.ExportManifest)
+	d.Set("s3_bucket", export.S3Bucket)
+	d.Set("s3_bucket_owner", export.S3BucketOwner)
+	d.Set("s3_prefix", export.S3Prefix)
+	d.Set("s3_sse_algorithm", export.S3SseAlgorithm)
+	d.Set("s3_sse_kms_key_id", export.S3SseKmsKeyId)
+	d.Set("table_arn", export.TableArn)
+
+	if export.EndTime != nil {
+		d.Set("end_time", aws.TimeValue(export.EndTime).Format(time.RFC3339))
+	} else {
+		d.Set("end_time", nil)
+	}
+
+	if export.ExportTime != nil {
+		d.Set("export_time", aws.TimeValue(export.ExportTime).Format(time.RFC3339))
+	} else {
+		d.Set("export_time", nil)
+	}
+
+	if export.StartTime != nil {
+		d.Set("start_time", aws.TimeValue(export.StartTime).Format(time.RFC3339))
+	} else {
+		d.Set("start_time", nil)
+	}
+
+	return nil
+}
+
+func resourceTableExportDelete(d *schema.ResourceData, meta interface{}) error {
+	// Exports cannot be deleted; the exported data is left in S3.
+	log.Printf("[DEBUG] Removing DynamoDB Table Export (%s) from Terraform state", d.Id())
+
+	return nil
+}
@@ -0,0 +1,153 @@
+package dynamodb_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/nij4t/terraform-provider-aws/internal/acctest"
+	"github.com/nij4t/terraform-provider-aws/internal/conns"
+	tfdynamodb "github.com/nij4t/terraform-provider-aws/internal/service/dynamodb"
+)
+
+func TestAccDynamoDBTableExport_basic(t *testing.T) {
+	var export dynamodb.ExportDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_export.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableExportConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableExportExists(resourceName, &export),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "dynamodb", regexp.MustCompile(fmt.Sprintf(`table/%s/export/.+$`, rName))),
+					resource.TestCheckResourceAttr(resourceName, "export_format", dynamodb.ExportFormatDynamodbJson),
+					resource.TestCheckResourceAttr(resourceName, "export_status", dynamodb.ExportStatusCompleted),
+					resource.TestCheckResourceAttr(resourceName, "item_count", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "billed_size_in_bytes"),
+					resource.TestCheckResourceAttrSet(resourceName, "end_time"),
+					resource.TestCheckResourceAttrSet(resourceName, "export_time"),
+					resource.TestCheckResourceAttrSet(resourceName, "manifest_files_s3_key"),
+					resource.TestCheckResourceAttrSet(resourceName, "start_time"),
+					resource.TestCheckResourceAttrPair(resourceName, "s3_bucket", "aws_s3_bucket.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "table_arn", "aws_dynamodb_table.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableExport_s3Prefix(t *testing.T) {
+	var export dynamodb.ExportDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_export.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableExportS3PrefixConfig(rName, "exports/test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableExportExists(resourceName, &export),
+					resource.TestCheckResourceAttr(resourceName, "s3_prefix", "exports/test"),
+					resource.TestMatchResourceAttr(resourceName, "manifest_files_s3_key", regexp.MustCompile(`^exports/test/`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTableExportExists(n string, v *dynamodb.ExportDescription) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DynamoDB Table Export ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn
+
+		output, err := tfdynamodb.FindDynamoDBTableExportByARN(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("DynamoDB Table Export (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccTableExportBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_dynamodb_table" "test" {
+  name           = %[1]q
+  read_capacity  = 2
+  write_capacity = 2
+  hash_key       = "TestTableHashKey"
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+
+  point_in_time_recovery {
+    enabled = true
+  }
+}
+`, rName)
+}
+
+func testAccTableExportConfig(rName string) string {
+	return acctest.ConfigCompose(testAccTableExportBaseConfig(rName), `
+resource "aws_dynamodb_table_export" "test" {
+  s3_bucket = aws_s3_bucket.test.id
+  table_arn = aws_dynamodb_table.test.arn
+}
+`)
+}
+
+func testAccTableExportS3PrefixConfig(rName, prefix string) string {
+	return acctest.ConfigCompose(testAccTableExportBaseConfig(rName), fmt.Sprintf(`
+resource "aws_dynamodb_table_export" "test" {
+  s3_bucket = aws_s3_bucket.test.id
+  s3_prefix = %[1]q
+  table_arn = aws_dynamodb_table.test.arn
+}
+`, prefix))
+}
@@ -0,0 +1,300 @@
+package dynamodb
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nij4t/terraform-provider-aws/internal/conns"
+	tftags "github.com/nij4t/terraform-provider-aws/internal/tags"
+	"github.com/nij4t/terraform-provider-aws/internal/verify"
+)
+
+func ResourceTableReplica() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTableReplicaCreate,
+		Read:   resourceTableReplicaRead,
+		Update: resourceTableReplicaUpdate,
+		Delete: resourceTableReplicaDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"global_table_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"kms_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"point_in_time_recovery": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceTableReplicaCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DynamoDBConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	// e.g. arn:aws:dynamodb:us-east-1:123456789012:table/example
+	globalTableARN, err := arn.Parse(d.Get("global_table_arn").(string))
+
+	if err != nil {
+		return fmt.Errorf("error parsing global table ARN: %w", err)
+	}
+
+	tableName := strings.TrimPrefix(globalTableARN.Resource, "table/")
+	mainRegion := globalTableARN.Region
+	replicaRegion := meta.(*conns.AWSClient).Region
+
+	if mainRegion == replicaRegion {
+		return fmt.Errorf("DynamoDB Table (%s) replica must be in a different region than the global table (%s)", tableName, mainRegion)
+	}
+
+	mainConn, err := tableReplicaMainConn(mainRegion, meta)
+
+	if err != nil {
+		return err
+	}
+
+	replica := map[string]interface{}{
+		"region_name": replicaRegion,
+	}
+
+	if v, ok := d.GetOk("kms_key_arn"); ok {
+		replica["kms_key_arn"] = v.(string)
+	}
+
+	log.Printf("[DEBUG] Creating DynamoDB Table (%s) replica (%s)", tableName, replicaRegion)
+	if err := createDynamoDbReplicas(tableName, []interface{}{replica}, mainConn); err != nil {
+		return err
+	}
+
+	d.SetId(TableReplicaCreateID(tableName, mainRegion))
+
+	if d.Get("point_in_time_recovery").(bool) {
+		if err := updateDynamoDbPITR(tableName, true, conn); err != nil {
+			return fmt.Errorf("error enabling DynamoDB Table (%s) replica (%s) point in time recovery: %w", tableName, replicaRegion, err)
+		}
+	}
+
+	if len(tags) > 0 {
+		table, err := FindDynamoDBTableByName(conn, tableName)
+
+		if err != nil {
+			return fmt.Errorf("error reading DynamoDB Table (%s) replica (%s): %w", tableName, replicaRegion, err)
+		}
+
+		if err := UpdateTags(conn, aws.StringValue(table.TableArn), nil, tags); err != nil {
+			return fmt.Errorf("error adding DynamoDB Table (%s) replica (%s) tags: %w", tableName, replicaRegion, err)
+		}
+	}
+
+	return resourceTableReplicaRead(d, meta)
+}
+
+func resourceTableReplicaRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DynamoDBConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	tableName, mainRegion, err := TableReplicaParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	replicaRegion := meta.(*conns.AWSClient).Region
+
+	mainConn, err := tableReplicaMainConn(mainRegion, meta)
+
+	if err != nil {
+		return err
+	}
+
+	globalTable, err := FindDynamoDBTableByName(mainConn, tableName)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] DynamoDB Table (%s) not found, removing replica (%s) from state", tableName, replicaRegion)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading DynamoDB Table (%s): %w", tableName, err)
+	}
+
+	if globalTable == nil {
+		return fmt.Errorf("error reading DynamoDB Table (%s): empty output", tableName)
+	}
+
+	var replica *dynamodb.ReplicaDescription
+
+	for _, v := range globalTable.Replicas {
+		if aws.StringValue(v.RegionName) == replicaRegion {
+			replica = v
+			break
+		}
+	}
+
+	if replica == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading DynamoDB Table (%s) replica (%s): not found after creation", tableName, replicaRegion)
+		}
+
+		log.Printf("[WARN] DynamoDB Table (%s) replica (%s) not found, removing from state", tableName, replicaRegion)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("global_table_arn", globalTable.TableArn)
+	d.Set("kms_key_arn", replica.KMSMasterKeyId)
+
+	table, err := FindDynamoDBTableByName(conn, tableName)
+
+	if err != nil {
+		return fmt.Errorf("error reading DynamoDB Table (%s) replica (%s): %w", tableName, replicaRegion, err)
+	}
+
+	if table == nil {
+		return fmt.Errorf("error reading DynamoDB Table (%s) replica (%s): empty output", tableName, replicaRegion)
+	}
+
+	d.Set("arn", table.TableArn)
+
+	pitr, err := FindDynamoDBPITRDescriptionByTableName(conn, tableName)
+
+	if err != nil && !tfawserr.ErrCodeEquals(err, "UnknownOperationException") {
+		return fmt.Errorf("error describing DynamoDB Table (%s) replica (%s) Continuous Backups: %w", tableName, replicaRegion, err)
+	}
+
+	d.Set("point_in_time_recovery", pitr != nil && aws.StringValue(pitr.PointInTimeRecoveryStatus) == dynamodb.PointInTimeRecoveryStatusEnabled)
+
+	tags, err := ListTags(conn, aws.StringValue(table.TableArn))
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for DynamoDB Table (%s) replica (%s): %w", tableName, replicaRegion, err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceTableReplicaUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DynamoDBConn
+
+	tableName, _, err := TableReplicaParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	replicaRegion := meta.(*conns.AWSClient).Region
+
+	if d.HasChange("point_in_time_recovery") {
+		if err := updateDynamoDbPITR(tableName, d.Get("point_in_time_recovery").(bool), conn); err != nil {
+			return fmt.Errorf("error updating DynamoDB Table (%s) replica (%s) point in time recovery: %w", tableName, replicaRegion, err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating DynamoDB Table (%s) replica (%s) tags: %w", tableName, replicaRegion, err)
+		}
+	}
+
+	return resourceTableReplicaRead(d, meta)
+}
+
+func resourceTableReplicaDelete(d *schema.ResourceData, meta interface{}) error {
+	tableName, mainRegion, err := TableReplicaParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	replicaRegion := meta.(*conns.AWSClient).Region
+
+	mainConn, err := tableReplicaMainConn(mainRegion, meta)
+
+	if err != nil {
+		return err
+	}
+
+	replica := map[string]interface{}{
+		"region_name": replicaRegion,
+	}
+
+	log.Printf("[DEBUG] Deleting DynamoDB Table (%s) replica (%s)", tableName, replicaRegion)
+	err = deleteDynamoDbReplicas(tableName, []interface{}{replica}, mainConn)
+
+	if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	return err
+}
+
+// tableReplicaMainConn returns a connection to the region of the global table,
+// where replicas are added and removed.
+// The connection does not use the custom DynamoDB endpoint of the replica's region.
+func tableReplicaMainConn(mainRegion string, meta interface{}) (*dynamodb.DynamoDB, error) {
+	client, err := meta.(*conns.AWSClient).RegionalClient(mainRegion)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return client.DynamoDBConn, nil
+}
+
+func TableReplicaCreateID(tableName, mainRegion string) string {
+	return fmt.Sprintf("%s:%s", tableName, mainRegion)
+}
+
+func TableReplicaParseID(id string) (string, string, error) {
+	parts := strings.Split(id, ":")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected TABLE_NAME:MAIN_REGION", id)
+	}
+
+	return parts[0], parts[1], nil
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/nij4t/terraform-provider-aws/internal/acctest"
+	"github.com/nij4t/terraform-provider-aws/internal/acctest/fakeaws"
 	"github.com/nij4t/terraform-provider-aws/internal/conns"
 	tfdynamodb "github.com/nij4t/terraform-provider-aws/internal/service/dynamodb"
 )
@@ -2449,3 +2450,60 @@ resource "aws_dynamodb_table" "test" {
 }
 `, rName, lsiName)
 }
+
+func TestDynamoDBTable_fakeAWS(t *testing.T) {
+	p, _ := acctest.FakeAWSProvider(t)
+	r := acctest.NewFakeAWSResource(t, p, "aws_dynamodb_table")
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	attribute := []interface{}{
+		map[string]interface{}{
+			"name": rName,
+			"type": "S",
+		},
+	}
+
+	state := r.Apply(map[string]interface{}{
+		"name":         rName,
+		"hash_key":     rName,
+		"billing_mode": "PAY_PER_REQUEST",
+		"attribute":    attribute,
+	})
+
+	if got, want := state.Attributes["arn"], fmt.Sprintf("arn:%s:dynamodb:%s:%s:table/%s", acctest.Partition(), acctest.Region(), fakeaws.AccountID, rName); got != want {
+		t.Errorf("arn = %q, want %q", got, want)
+	}
+
+	state = r.Apply(map[string]interface{}{
+		"name":           rName,
+		"hash_key":       rName,
+		"billing_mode":   "PROVISIONED",
+		"read_capacity":  1,
+		"write_capacity": 1,
+		"attribute":      attribute,
+		"point_in_time_recovery": []interface{}{
+			map[string]interface{}{
+				"enabled": true,
+			},
+		},
+		"ttl": []interface{}{
+			map[string]interface{}{
+				"attribute_name": "TestTTL",
+				"enabled":        true,
+			},
+		},
+		"tags": map[string]interface{}{
+			"key1": "value1",
+		},
+	})
+
+	if got, want := state.Attributes["billing_mode"], "PROVISIONED"; got != want {
+		t.Errorf("billing_mode = %q, want %q", got, want)
+	}
+
+	if got, want := state.Attributes["point_in_time_recovery.0.enabled"], "true"; got != want {
+		t.Errorf("point_in_time_recovery.0.enabled = %q, want %q", got, want)
+	}
+
+	r.ImportStateVerify()
+	r.Destroy()
+}
@@ -2,10 +2,14 @@ package dynamodb
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/nij4t/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -19,6 +23,8 @@ const (
 	deleteTableTimeout                         = 10 * time.Minute
 	pitrUpdateTimeout                          = 30 * time.Second
 	ttlUpdateTimeout                           = 30 * time.Second
+	contributorInsightsUpdateTimeout           = 5 * time.Minute
+	tableExportCreatedTimeout                  = 60 * time.Minute
 )
 
 func waitDynamoDBKinesisStreamingDestinationActive(ctx context.Context, conn *dynamodb.DynamoDB, streamArn, tableName string) error {
@@ -258,3 +264,63 @@ func waitDynamoDBSSEUpdated(conn *dynamodb.DynamoDB, tableName string) (*dynamod
 
 	return nil, err
 }
+
+func waitDynamoDBContributorInsightsEnabled(conn *dynamodb.DynamoDB, tableName, indexName string) (*dynamodb.DescribeContributorInsightsOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{dynamodb.ContributorInsightsStatusEnabling},
+		Target:  []string{dynamodb.ContributorInsightsStatusEnabled},
+		Timeout: contributorInsightsUpdateTimeout,
+		Refresh: statusDynamoDBContributorInsights(conn, tableName, indexName),
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*dynamodb.DescribeContributorInsightsOutput); ok {
+		if v := output.FailureException; v != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(v.ExceptionName), aws.StringValue(v.ExceptionDescription)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDynamoDBContributorInsightsDisabled(conn *dynamodb.DynamoDB, tableName, indexName string) (*dynamodb.DescribeContributorInsightsOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{dynamodb.ContributorInsightsStatusDisabling},
+		Target:  []string{dynamodb.ContributorInsightsStatusDisabled},
+		Timeout: contributorInsightsUpdateTimeout,
+		Refresh: statusDynamoDBContributorInsights(conn, tableName, indexName),
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*dynamodb.DescribeContributorInsightsOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDynamoDBTableExportCreated(conn *dynamodb.DynamoDB, arn string, timeout time.Duration) (*dynamodb.ExportDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{dynamodb.ExportStatusInProgress},
+		Target:  []string{dynamodb.ExportStatusCompleted},
+		Timeout: timeout,
+		Refresh: statusDynamoDBTableExport(conn, arn),
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*dynamodb.ExportDescription); ok {
+		if aws.StringValue(output.ExportStatus) == dynamodb.ExportStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.FailureMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
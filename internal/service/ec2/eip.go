@@ -11,9 +11,11 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/nij4t/terraform-provider-aws/internal/conns"
+	tfservicequotas "github.com/nij4t/terraform-provider-aws/internal/service/servicequotas"
 	tftags "github.com/nij4t/terraform-provider-aws/internal/tags"
 	"github.com/nij4t/terraform-provider-aws/internal/tfresource"
 	"github.com/nij4t/terraform-provider-aws/internal/verify"
@@ -34,7 +36,10 @@ func ResourceEIP() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			tfservicequotas.QuotaCheckCustomizeDiff(eipQuotaCheck),
+		),
 
 		Timeouts: &schema.ResourceTimeout{
 			Read:   schema.DefaultTimeout(15 * time.Minute),
@@ -0,0 +1,183 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nij4t/terraform-provider-aws/internal/conns"
+)
+
+func ResourceInstanceState() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceInstanceStateCreate,
+		Read:   resourceInstanceStateRead,
+		Update: resourceInstanceStateUpdate,
+		Delete: resourceInstanceStateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"force": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.InstanceStateNameRunning,
+					ec2.InstanceStateNameStopped,
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceInstanceStateCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	instanceID := d.Get("instance_id").(string)
+
+	instance, err := WaitInstanceReady(conn, instanceID, d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
+		return fmt.Errorf("error waiting for EC2 Instance (%s) to be ready: %w", instanceID, err)
+	}
+
+	err = updateInstanceState(conn, instanceID, aws.StringValue(instance.State.Name), d.Get("state").(string), d.Get("force").(bool), d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(instanceID)
+
+	return resourceInstanceStateRead(d, meta)
+}
+
+func resourceInstanceStateRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	instance, err := FindInstanceByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, ErrCodeInvalidInstanceIDNotFound) {
+		log.Printf("[WARN] EC2 Instance (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Instance (%s): %w", d.Id(), err)
+	}
+
+	if instance == nil || instance.State == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading EC2 Instance (%s): not found", d.Id())
+		}
+
+		log.Printf("[WARN] EC2 Instance (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	state := aws.StringValue(instance.State.Name)
+
+	if !d.IsNewResource() && (state == ec2.InstanceStateNameShuttingDown || state == ec2.InstanceStateNameTerminated) {
+		log.Printf("[WARN] EC2 Instance (%s) is %s, removing from state", d.Id(), state)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("instance_id", instance.InstanceId)
+	d.Set("state", state)
+
+	if _, ok := d.GetOk("force"); !ok {
+		d.Set("force", false)
+	}
+
+	return nil
+}
+
+func resourceInstanceStateUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	instance, err := WaitInstanceReady(conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("error waiting for EC2 Instance (%s) to be ready: %w", d.Id(), err)
+	}
+
+	if d.HasChange("state") {
+		err := updateInstanceState(conn, d.Id(), aws.StringValue(instance.State.Name), d.Get("state").(string), d.Get("force").(bool), d.Timeout(schema.TimeoutUpdate))
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceInstanceStateRead(d, meta)
+}
+
+func resourceInstanceStateDelete(d *schema.ResourceData, meta interface{}) error {
+	// The instance is left in whatever state it is in; only Terraform's management of that state ends.
+	log.Printf("[DEBUG] Removing EC2 Instance state (%s) from Terraform state", d.Id())
+
+	return nil
+}
+
+func updateInstanceState(conn *ec2.EC2, instanceID string, currentState string, configuredState string, force bool, timeout time.Duration) error {
+	if currentState == configuredState {
+		return nil
+	}
+
+	switch configuredState {
+	case ec2.InstanceStateNameStopped:
+		log.Printf("[INFO] Stopping EC2 Instance (%s) (force: %t)", instanceID, force)
+		_, err := conn.StopInstances(&ec2.StopInstancesInput{
+			Force:       aws.Bool(force),
+			InstanceIds: aws.StringSlice([]string{instanceID}),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error stopping EC2 Instance (%s): %w", instanceID, err)
+		}
+
+		if _, err := WaitInstanceStopped(conn, instanceID, timeout); err != nil {
+			return fmt.Errorf("error waiting for EC2 Instance (%s) to stop: %w", instanceID, err)
+		}
+
+	case ec2.InstanceStateNameRunning:
+		log.Printf("[INFO] Starting EC2 Instance (%s)", instanceID)
+		_, err := conn.StartInstances(&ec2.StartInstancesInput{
+			InstanceIds: aws.StringSlice([]string{instanceID}),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error starting EC2 Instance (%s): %w", instanceID, err)
+		}
+
+		if _, err := WaitInstanceStarted(conn, instanceID, timeout); err != nil {
+			return fmt.Errorf("error waiting for EC2 Instance (%s) to start: %w", instanceID, err)
+		}
+	}
+
+	return nil
+}
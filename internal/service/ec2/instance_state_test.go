@@ -0,0 +1,127 @@
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/nij4t/terraform-provider-aws/internal/acctest"
+	"github.com/nij4t/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/nij4t/terraform-provider-aws/internal/service/ec2"
+)
+
+func TestAccEC2InstanceState_basic(t *testing.T) {
+	resourceName := "aws_ec2_instance_state.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceStateConfig(rName, ec2.InstanceStateNameStopped, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceStateExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_id", "aws_instance.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "force", "false"),
+					resource.TestCheckResourceAttr(resourceName, "state", ec2.InstanceStateNameStopped),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2InstanceState_state(t *testing.T) {
+	resourceName := "aws_ec2_instance_state.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceStateConfig(rName, ec2.InstanceStateNameStopped, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceStateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "state", ec2.InstanceStateNameStopped),
+				),
+			},
+			{
+				Config: testAccInstanceStateConfig(rName, ec2.InstanceStateNameRunning, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceStateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "state", ec2.InstanceStateNameRunning),
+				),
+			},
+			{
+				Config: testAccInstanceStateConfig(rName, ec2.InstanceStateNameStopped, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceStateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "force", "true"),
+					resource.TestCheckResourceAttr(resourceName, "state", ec2.InstanceStateNameStopped),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckInstanceStateExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Instance ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		instance, err := tfec2.FindInstanceByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if instance == nil {
+			return fmt.Errorf("EC2 Instance (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccInstanceStateConfig(rName, state string, force bool) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinuxHvmEbsAmi(),
+		acctest.AvailableEC2InstanceTypeForRegion("t3.micro", "t2.micro", "t1.micro", "m1.small"),
+		fmt.Sprintf(`
+resource "aws_instance" "test" {
+  ami           = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_instance_state" "test" {
+  instance_id = aws_instance.test.id
+  state       = %[2]q
+  force       = %[3]t
+}
+`, rName, state, force))
+}
@@ -87,7 +87,7 @@ func resourceKeyPairCreate(d *schema.ResourceData, meta interface{}) error {
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
-	keyName := create.Name(d.Get("key_name").(string), d.Get("key_name_prefix").(string))
+	keyName := create.Name(meta.(*conns.AWSClient).NamingPolicy, d.Get("key_name").(string), d.Get("key_name_prefix").(string))
 
 	input := &ec2.ImportKeyPairInput{
 		KeyName:           aws.String(keyName),
@@ -668,7 +668,7 @@ func resourceLaunchTemplateCreate(d *schema.ResourceData, meta interface{}) erro
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
-	ltName := create.Name(d.Get("name").(string), d.Get("name_prefix").(string))
+	ltName := create.Name(meta.(*conns.AWSClient).NamingPolicy, d.Get("name").(string), d.Get("name_prefix").(string))
 
 	launchTemplateData, err := buildLaunchTemplateData(d)
 	if err != nil {
@@ -0,0 +1,67 @@
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nij4t/terraform-provider-aws/internal/conns"
+	tfservicequotas "github.com/nij4t/terraform-provider-aws/internal/service/servicequotas"
+)
+
+// vpcQuotaCheck checks the VPCs per Region quota when planning new VPCs.
+var vpcQuotaCheck = tfservicequotas.QuotaCheck{
+	ServiceCode: "vpc",
+	QuotaCode:   "L-F678F1CE",
+	Description: "VPCs per Region",
+	Usage:       vpcQuotaUsage,
+	Planned:     tfservicequotas.PlannedCreate,
+}
+
+func vpcQuotaUsage(ctx context.Context, client *conns.AWSClient) (float64, error) {
+	var usage float64
+
+	err := client.EC2Conn.DescribeVpcsPagesWithContext(ctx, &ec2.DescribeVpcsInput{}, func(page *ec2.DescribeVpcsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		usage += float64(len(page.Vpcs))
+
+		return !lastPage
+	})
+
+	return usage, err
+}
+
+// eipQuotaCheck checks the EC2-VPC Elastic IPs quota when planning new VPC Elastic IPs.
+var eipQuotaCheck = tfservicequotas.QuotaCheck{
+	ServiceCode: "ec2",
+	QuotaCode:   "L-0263D0A3",
+	Description: "EC2-VPC Elastic IPs",
+	Usage:       eipQuotaUsage,
+	Planned:     eipQuotaPlanned,
+}
+
+func eipQuotaUsage(ctx context.Context, client *conns.AWSClient) (float64, error) {
+	output, err := client.EC2Conn.DescribeAddressesWithContext(ctx, &ec2.DescribeAddressesInput{
+		Filters: BuildAttributeFilterList(map[string]string{
+			"domain": ec2.DomainTypeVpc,
+		}),
+	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	return float64(len(output.Addresses)), nil
+}
+
+func eipQuotaPlanned(diff *schema.ResourceDiff) float64 {
+	// EC2-Classic Elastic IPs have a separate quota.
+	if v, ok := diff.GetOkExists("vpc"); ok && !v.(bool) {
+		return 0
+	}
+
+	return tfservicequotas.PlannedCreate(diff)
+}
@@ -260,7 +260,7 @@ func resourceSecurityGroupCreate(d *schema.ResourceData, meta interface{}) error
 		securityGroupOpts.Description = aws.String(v.(string))
 	}
 
-	groupName := create.Name(d.Get("name").(string), d.Get("name_prefix").(string))
+	groupName := create.Name(meta.(*conns.AWSClient).NamingPolicy, d.Get("name").(string), d.Get("name_prefix").(string))
 	securityGroupOpts.GroupName = aws.String(groupName)
 
 	var err error
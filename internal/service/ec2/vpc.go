@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/nij4t/terraform-provider-aws/internal/conns"
+	tfservicequotas "github.com/nij4t/terraform-provider-aws/internal/service/servicequotas"
 	tftags "github.com/nij4t/terraform-provider-aws/internal/tags"
 	"github.com/nij4t/terraform-provider-aws/internal/tfresource"
 	"github.com/nij4t/terraform-provider-aws/internal/verify"
@@ -35,6 +36,7 @@ func ResourceVPC() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			resourceVPCCustomizeDiff,
 			verify.SetTagsDiff,
+			tfservicequotas.QuotaCheckCustomizeDiff(vpcQuotaCheck),
 		),
 
 		SchemaVersion: 1,
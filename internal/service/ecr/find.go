@@ -0,0 +1,79 @@
+package ecr
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/nij4t/terraform-provider-aws/internal/tfresource"
+)
+
+// FindImageScanFindings returns the scan status and all findings of an image scan,
+// combining the findings from every page of results.
+func FindImageScanFindings(conn *ecr.ECR, input *ecr.DescribeImageScanFindingsInput) (*ecr.DescribeImageScanFindingsOutput, error) {
+	var output *ecr.DescribeImageScanFindingsOutput
+
+	err := conn.DescribeImageScanFindingsPages(input, func(page *ecr.DescribeImageScanFindingsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		if output == nil {
+			output = page
+		} else if page.ImageScanFindings != nil {
+			if output.ImageScanFindings == nil {
+				output.ImageScanFindings = &ecr.ImageScanFindings{}
+			}
+
+			output.ImageScanFindings.Findings = append(output.ImageScanFindings.Findings, page.ImageScanFindings.Findings...)
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, ecr.ErrCodeImageNotFoundException, ecr.ErrCodeRepositoryNotFoundException, ecr.ErrCodeScanNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ImageScanStatus == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// findImageScanStatus returns the scan status of an image scan from a single request,
+// without paging through the findings.
+func findImageScanStatus(conn *ecr.ECR, input *ecr.DescribeImageScanFindingsInput) (*ecr.DescribeImageScanFindingsOutput, error) {
+	input = &ecr.DescribeImageScanFindingsInput{
+		ImageId:        input.ImageId,
+		RegistryId:     input.RegistryId,
+		RepositoryName: input.RepositoryName,
+	}
+
+	output, err := conn.DescribeImageScanFindings(input)
+
+	if tfawserr.ErrCodeEquals(err, ecr.ErrCodeImageNotFoundException, ecr.ErrCodeRepositoryNotFoundException, ecr.ErrCodeScanNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ImageScanStatus == nil || aws.StringValue(output.ImageScanStatus.Status) == "" {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,201 @@
+package ecr
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nij4t/terraform-provider-aws/internal/conns"
+)
+
+const (
+	imageScanCompletedTimeout = 20 * time.Minute
+)
+
+func DataSourceImageScanFindings() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceImageScanFindingsRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(imageScanCompletedTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"finding_severity_counts": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"findings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attributes": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"severity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"uri": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"image_digest": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"image_digest", "image_tag"},
+			},
+			"image_scan_completed_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"image_scan_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"image_scan_status_description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"image_tag": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"image_digest", "image_tag"},
+			},
+			"registry_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"repository_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"vulnerability_source_updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceImageScanFindingsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ECRConn
+
+	repositoryName := d.Get("repository_name").(string)
+	input := &ecr.DescribeImageScanFindingsInput{
+		ImageId:        &ecr.ImageIdentifier{},
+		RepositoryName: aws.String(repositoryName),
+	}
+
+	if v, ok := d.GetOk("image_digest"); ok {
+		input.ImageId.ImageDigest = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("image_tag"); ok {
+		input.ImageId.ImageTag = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("registry_id"); ok {
+		input.RegistryId = aws.String(v.(string))
+	}
+
+	if _, err := waitImageScanCompleted(conn, input, d.Timeout(schema.TimeoutRead)); err != nil {
+		return fmt.Errorf("error waiting for ECR Repository (%s) image scan to complete: %w", repositoryName, err)
+	}
+
+	log.Printf("[DEBUG] Reading ECR Image Scan Findings: %s", input)
+	output, err := FindImageScanFindings(conn, input)
+
+	if err != nil {
+		return fmt.Errorf("error reading ECR Repository (%s) image scan findings: %w", repositoryName, err)
+	}
+
+	d.SetId(aws.StringValue(output.ImageId.ImageDigest))
+	d.Set("image_digest", output.ImageId.ImageDigest)
+	d.Set("image_scan_status", output.ImageScanStatus.Status)
+	d.Set("image_scan_status_description", output.ImageScanStatus.Description)
+	d.Set("registry_id", output.RegistryId)
+	d.Set("repository_name", output.RepositoryName)
+
+	findings := output.ImageScanFindings
+
+	if findings == nil {
+		findings = &ecr.ImageScanFindings{}
+	}
+
+	severityCounts := make(map[string]interface{}, len(findings.FindingSeverityCounts))
+
+	for k, v := range findings.FindingSeverityCounts {
+		severityCounts[k] = int(aws.Int64Value(v))
+	}
+
+	if err := d.Set("finding_severity_counts", severityCounts); err != nil {
+		return fmt.Errorf("error setting finding_severity_counts: %w", err)
+	}
+
+	if err := d.Set("findings", flattenImageScanFindings(findings.Findings)); err != nil {
+		return fmt.Errorf("error setting findings: %w", err)
+	}
+
+	if findings.ImageScanCompletedAt != nil {
+		d.Set("image_scan_completed_at", aws.TimeValue(findings.ImageScanCompletedAt).Format(time.RFC3339))
+	} else {
+		d.Set("image_scan_completed_at", nil)
+	}
+
+	if findings.VulnerabilitySourceUpdatedAt != nil {
+		d.Set("vulnerability_source_updated_at", aws.TimeValue(findings.VulnerabilitySourceUpdatedAt).Format(time.RFC3339))
+	} else {
+		d.Set("vulnerability_source_updated_at", nil)
+	}
+
+	return nil
+}
+
+func flattenImageScanFindings(apiObjects []*ecr.ImageScanFinding) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		attributes := make(map[string]interface{}, len(apiObject.Attributes))
+
+		for _, attribute := range apiObject.Attributes {
+			attributes[aws.StringValue(attribute.Key)] = aws.StringValue(attribute.Value)
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"attributes":  attributes,
+			"description": aws.StringValue(apiObject.Description),
+			"name":        aws.StringValue(apiObject.Name),
+			"severity":    aws.StringValue(apiObject.Severity),
+			"uri":         aws.StringValue(apiObject.Uri),
+		})
+	}
+
+	return tfList
+}
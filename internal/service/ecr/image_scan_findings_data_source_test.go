@@ -0,0 +1,68 @@
+package ecr_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/nij4t/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccECRImageScanFindingsDataSource_basic(t *testing.T) {
+	repositoryName := os.Getenv("ECR_SCANNED_REPOSITORY_NAME")
+	if repositoryName == "" {
+		t.Skip("Environment variable ECR_SCANNED_REPOSITORY_NAME is not set")
+	}
+
+	imageTag := os.Getenv("ECR_SCANNED_IMAGE_TAG")
+	if imageTag == "" {
+		imageTag = "latest"
+	}
+
+	byTag := "data.aws_ecr_image_scan_findings.by_tag"
+	byDigest := "data.aws_ecr_image_scan_findings.by_digest"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, ecr.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImageScanFindingsDataSourceConfig(repositoryName, imageTag),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(byTag, "image_digest", "data.aws_ecr_image.test", "image_digest"),
+					resource.TestCheckResourceAttrSet(byTag, "image_scan_completed_at"),
+					resource.TestCheckResourceAttr(byTag, "image_scan_status", ecr.ScanStatusComplete),
+					resource.TestCheckResourceAttrSet(byTag, "finding_severity_counts.%"),
+					resource.TestCheckResourceAttrSet(byTag, "findings.#"),
+					resource.TestCheckResourceAttrSet(byTag, "registry_id"),
+					resource.TestCheckResourceAttr(byTag, "repository_name", repositoryName),
+					resource.TestCheckResourceAttrPair(byDigest, "finding_severity_counts.%", byTag, "finding_severity_counts.%"),
+					resource.TestCheckResourceAttrPair(byDigest, "findings.#", byTag, "findings.#"),
+					resource.TestCheckResourceAttrPair(byDigest, "image_scan_completed_at", byTag, "image_scan_completed_at"),
+				),
+			},
+		},
+	})
+}
+
+func testAccImageScanFindingsDataSourceConfig(repositoryName, imageTag string) string {
+	return fmt.Sprintf(`
+data "aws_ecr_image" "test" {
+  repository_name = %[1]q
+  image_tag       = %[2]q
+}
+
+data "aws_ecr_image_scan_findings" "by_tag" {
+  repository_name = %[1]q
+  image_tag       = %[2]q
+}
+
+data "aws_ecr_image_scan_findings" "by_digest" {
+  repository_name = %[1]q
+  image_digest    = data.aws_ecr_image.test.image_digest
+}
+`, repositoryName, imageTag)
+}
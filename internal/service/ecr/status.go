@@ -0,0 +1,24 @@
+package ecr
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/nij4t/terraform-provider-aws/internal/tfresource"
+)
+
+func statusImageScan(conn *ecr.ECR, input *ecr.DescribeImageScanFindingsInput) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findImageScanStatus(conn, input)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.ImageScanStatus.Status), nil
+	}
+}
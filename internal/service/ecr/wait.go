@@ -1,10 +1,37 @@
 package ecr
 
 import (
+	"errors"
 	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/nij4t/terraform-provider-aws/internal/tfresource"
 )
 
 const (
 	// Maximum amount of time to wait for ECR changes to propagate
 	propagationTimeout = 2 * time.Minute
 )
+
+func waitImageScanCompleted(conn *ecr.ECR, input *ecr.DescribeImageScanFindingsInput, timeout time.Duration) (*ecr.DescribeImageScanFindingsOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ecr.ScanStatusInProgress},
+		Target:  []string{ecr.ScanStatusComplete},
+		Refresh: statusImageScan(conn, input),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ecr.DescribeImageScanFindingsOutput); ok {
+		if status := aws.StringValue(output.ImageScanStatus.Status); status == ecr.ScanStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.ImageScanStatus.Description)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
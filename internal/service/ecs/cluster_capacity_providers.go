@@ -0,0 +1,172 @@
+package ecs
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nij4t/terraform-provider-aws/internal/conns"
+	"github.com/nij4t/terraform-provider-aws/internal/flex"
+	"github.com/nij4t/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceClusterCapacityProviders() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceClusterCapacityProvidersPut,
+		Read:   resourceClusterCapacityProvidersRead,
+		Update: resourceClusterCapacityProvidersPut,
+		Delete: resourceClusterCapacityProvidersDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"capacity_providers": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"cluster_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"default_capacity_provider_strategy": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"base": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 100000),
+						},
+						"capacity_provider": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"weight": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 1000),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceClusterCapacityProvidersPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ECSConn
+
+	clusterName := d.Get("cluster_name").(string)
+
+	input := &ecs.PutClusterCapacityProvidersInput{
+		Cluster:                         aws.String(clusterName),
+		CapacityProviders:               flex.ExpandStringSet(d.Get("capacity_providers").(*schema.Set)),
+		DefaultCapacityProviderStrategy: expandEcsCapacityProviderStrategy(d.Get("default_capacity_provider_strategy").(*schema.Set)),
+	}
+
+	log.Printf("[DEBUG] Updating ECS Cluster (%s) capacity providers: %s", clusterName, input)
+	if err := retryClusterCapacityProvidersPut(conn, input); err != nil {
+		return fmt.Errorf("error updating ECS Cluster (%s) capacity providers: %w", clusterName, err)
+	}
+
+	if _, err := waitClusterAvailable(conn, clusterName); err != nil {
+		return fmt.Errorf("error waiting for ECS Cluster (%s) to become Available: %w", clusterName, err)
+	}
+
+	d.SetId(clusterName)
+
+	return resourceClusterCapacityProvidersRead(d, meta)
+}
+
+func resourceClusterCapacityProvidersRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ECSConn
+
+	cluster, err := FindClusterByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] ECS Cluster (%s) not found, removing capacity providers from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading ECS Cluster (%s): %w", d.Id(), err)
+	}
+
+	if err := d.Set("capacity_providers", aws.StringValueSlice(cluster.CapacityProviders)); err != nil {
+		return fmt.Errorf("error setting capacity_providers: %w", err)
+	}
+
+	d.Set("cluster_name", cluster.ClusterName)
+
+	if err := d.Set("default_capacity_provider_strategy", flattenEcsCapacityProviderStrategy(cluster.DefaultCapacityProviderStrategy)); err != nil {
+		return fmt.Errorf("error setting default_capacity_provider_strategy: %w", err)
+	}
+
+	return nil
+}
+
+func resourceClusterCapacityProvidersDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ECSConn
+
+	input := &ecs.PutClusterCapacityProvidersInput{
+		Cluster:                         aws.String(d.Id()),
+		CapacityProviders:               []*string{},
+		DefaultCapacityProviderStrategy: []*ecs.CapacityProviderStrategyItem{},
+	}
+
+	log.Printf("[DEBUG] Removing ECS Cluster (%s) capacity providers", d.Id())
+	err := retryClusterCapacityProvidersPut(conn, input)
+
+	if tfawserr.ErrCodeEquals(err, ecs.ErrCodeClusterNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error removing ECS Cluster (%s) capacity providers: %w", d.Id(), err)
+	}
+
+	if _, err := waitClusterAvailable(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for ECS Cluster (%s) to become Available: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// retryClusterCapacityProvidersPut calls PutClusterCapacityProviders, retrying
+// while the cluster is still provisioning or a previous update is in progress.
+func retryClusterCapacityProvidersPut(conn *ecs.ECS, input *ecs.PutClusterCapacityProvidersInput) error {
+	err := resource.Retry(ecsClusterTimeoutUpdate, func() *resource.RetryError {
+		_, err := conn.PutClusterCapacityProviders(input)
+		if err != nil {
+			if tfawserr.ErrMessageContains(err, ecs.ErrCodeClientException, "Cluster was not ACTIVE") {
+				return resource.RetryableError(err)
+			}
+			if tfawserr.ErrMessageContains(err, ecs.ErrCodeResourceInUseException, "") {
+				return resource.RetryableError(err)
+			}
+			if tfawserr.ErrMessageContains(err, ecs.ErrCodeUpdateInProgressException, "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		_, err = conn.PutClusterCapacityProviders(input)
+	}
+
+	return err
+}
@@ -65,3 +65,33 @@ func FindClusterByARN(conn *ecs.ECS, arn string) (*ecs.DescribeClustersOutput, e
 
 	return output, nil
 }
+
+func FindClusterByName(conn *ecs.ECS, name string) (*ecs.Cluster, error) {
+	input := &ecs.DescribeClustersInput{
+		Clusters: aws.StringSlice([]string{name}),
+	}
+
+	output, err := conn.DescribeClusters(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Clusters) == 0 || output.Clusters[0] == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	cluster := output.Clusters[0]
+
+	if status := aws.StringValue(cluster.Status); status == "INACTIVE" {
+		return nil, &resource.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return cluster, nil
+}
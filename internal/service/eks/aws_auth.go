@@ -0,0 +1,419 @@
+package eks
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	awsAuthConfigMapName      = "aws-auth"
+	awsAuthConfigMapNamespace = "kube-system"
+
+	awsAuthMapRolesKey = "mapRoles"
+	awsAuthMapUsersKey = "mapUsers"
+
+	awsAuthConflictTimeout = 2 * time.Minute
+)
+
+var errAWSAuthConflict = errors.New("aws-auth ConfigMap was modified concurrently")
+
+// awsAuthHTTPClients caches an HTTP client, and so its idle connections, per Kubernetes API endpoint and CA.
+var (
+	awsAuthHTTPClients     = make(map[string]*http.Client)
+	awsAuthHTTPClientsLock sync.Mutex
+)
+
+// IdentityMapping is a single entry of the mapRoles or mapUsers lists in the aws-auth ConfigMap.
+type IdentityMapping struct {
+	ARN      string
+	Username string
+	Groups   []string
+}
+
+// AWSAuthClient reads and writes entries of the aws-auth ConfigMap through the Kubernetes API.
+// Writes use the ConfigMap's resourceVersion so that concurrent edits are never lost.
+type AWSAuthClient struct {
+	endpoint   string
+	token      string
+	httpClient *http.Client
+}
+
+// NewAWSAuthClient returns a client for the Kubernetes API at endpoint, trusting the
+// PEM encoded caData and authenticating with the bearer token.
+// Clients for the same endpoint and CA share their HTTP client.
+func NewAWSAuthClient(endpoint string, caData []byte, token string) (*AWSAuthClient, error) {
+	endpoint = strings.TrimSuffix(endpoint, "/")
+	httpClient, err := awsAuthHTTPClient(endpoint, caData)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &AWSAuthClient{
+		endpoint:   endpoint,
+		token:      token,
+		httpClient: httpClient,
+	}, nil
+}
+
+func awsAuthHTTPClient(endpoint string, caData []byte) (*http.Client, error) {
+	awsAuthHTTPClientsLock.Lock()
+	defer awsAuthHTTPClientsLock.Unlock()
+
+	key := endpoint + "\n" + string(caData)
+
+	if v, ok := awsAuthHTTPClients[key]; ok {
+		return v, nil
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if len(caData) > 0 {
+		pool := x509.NewCertPool()
+
+		if !pool.AppendCertsFromPEM(caData) {
+			return nil, errors.New("error parsing cluster certificate authority data")
+		}
+
+		tlsConfig.RootCAs = pool
+	}
+
+	v := &http.Client{
+		Timeout:   30 * time.Second,
+		Transport: &http.Transport{TLSClientConfig: tlsConfig},
+	}
+	awsAuthHTTPClients[key] = v
+
+	return v, nil
+}
+
+// FindIdentityMapping returns the mapping for arn from mapRoles (isRole) or mapUsers.
+func (c *AWSAuthClient) FindIdentityMapping(ctx context.Context, arn string, isRole bool) (*IdentityMapping, error) {
+	configMap, err := c.getConfigMap(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if configMap == nil {
+		return nil, &resource.NotFoundError{Message: "aws-auth ConfigMap not found"}
+	}
+
+	entries, err := configMap.entries(isRole)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		if mapping := identityMappingFromEntry(entry, isRole); mapping != nil && mapping.ARN == arn {
+			return mapping, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{Message: fmt.Sprintf("%s not mapped in aws-auth ConfigMap", arn)}
+}
+
+// PutIdentityMapping adds or replaces the entry for mapping.ARN, creating the ConfigMap if needed.
+func (c *AWSAuthClient) PutIdentityMapping(ctx context.Context, mapping *IdentityMapping, isRole bool) error {
+	return c.modify(ctx, isRole, func(entries []yaml.MapSlice) []yaml.MapSlice {
+		entry := identityMappingToEntry(mapping, isRole)
+
+		for i, v := range entries {
+			if m := identityMappingFromEntry(v, isRole); m != nil && m.ARN == mapping.ARN {
+				entries[i] = entry
+				return entries
+			}
+		}
+
+		return append(entries, entry)
+	})
+}
+
+// DeleteIdentityMapping removes the entry for arn, leaving all other entries untouched.
+func (c *AWSAuthClient) DeleteIdentityMapping(ctx context.Context, arn string, isRole bool) error {
+	return c.modify(ctx, isRole, func(entries []yaml.MapSlice) []yaml.MapSlice {
+		result := make([]yaml.MapSlice, 0, len(entries))
+
+		for _, v := range entries {
+			if m := identityMappingFromEntry(v, isRole); m != nil && m.ARN == arn {
+				continue
+			}
+
+			result = append(result, v)
+		}
+
+		return result
+	})
+}
+
+// modify performs a read-modify-write of one list in the ConfigMap, retrying on write conflicts.
+func (c *AWSAuthClient) modify(ctx context.Context, isRole bool, fn func([]yaml.MapSlice) []yaml.MapSlice) error {
+	err := resource.RetryContext(ctx, awsAuthConflictTimeout, func() *resource.RetryError {
+		err := c.modifyOnce(ctx, isRole, fn)
+
+		if errors.Is(err, errAWSAuthConflict) {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	return err
+}
+
+func (c *AWSAuthClient) modifyOnce(ctx context.Context, isRole bool, fn func([]yaml.MapSlice) []yaml.MapSlice) error {
+	configMap, err := c.getConfigMap(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	create := configMap == nil
+
+	if create {
+		configMap = newAWSAuthConfigMap()
+	}
+
+	entries, err := configMap.entries(isRole)
+
+	if err != nil {
+		return err
+	}
+
+	if err := configMap.setEntries(isRole, fn(entries)); err != nil {
+		return err
+	}
+
+	if create {
+		return c.do(ctx, http.MethodPost, c.configMapsPath(), configMap.raw, nil)
+	}
+
+	return c.do(ctx, http.MethodPut, c.configMapsPath()+"/"+awsAuthConfigMapName, configMap.raw, nil)
+}
+
+// getConfigMap returns the aws-auth ConfigMap, or nil if it does not exist.
+func (c *AWSAuthClient) getConfigMap(ctx context.Context) (*awsAuthConfigMap, error) {
+	raw := make(map[string]interface{})
+	err := c.do(ctx, http.MethodGet, c.configMapsPath()+"/"+awsAuthConfigMapName, nil, &raw)
+
+	var statusErr *kubernetesStatusError
+	if errors.As(err, &statusErr) && statusErr.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &awsAuthConfigMap{raw: raw}, nil
+}
+
+func (c *AWSAuthClient) configMapsPath() string {
+	return fmt.Sprintf("/api/v1/namespaces/%s/configmaps", awsAuthConfigMapNamespace)
+}
+
+type kubernetesStatusError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *kubernetesStatusError) Error() string {
+	return fmt.Sprintf("Kubernetes API returned HTTP %d: %s", e.StatusCode, e.Message)
+}
+
+func (c *AWSAuthClient) do(ctx context.Context, method, path string, in, out interface{}) error {
+	var body io.Reader
+
+	if in != nil {
+		b, err := json.Marshal(in)
+
+		if err != nil {
+			return err
+		}
+
+		body = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.endpoint+path, body)
+
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", "Bearer "+c.token)
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+
+	if err != nil {
+		return fmt.Errorf("error calling Kubernetes API (%s %s): %w", method, path, err)
+	}
+
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode == http.StatusConflict {
+		return errAWSAuthConflict
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		status := struct {
+			Message string `json:"message"`
+		}{}
+		_ = json.Unmarshal(b, &status)
+
+		return &kubernetesStatusError{StatusCode: resp.StatusCode, Message: status.Message}
+	}
+
+	if out != nil {
+		return json.Unmarshal(b, out)
+	}
+
+	return nil
+}
+
+// awsAuthConfigMap wraps the raw ConfigMap object so that fields Terraform
+// does not manage, including metadata.resourceVersion, are sent back unchanged.
+type awsAuthConfigMap struct {
+	raw map[string]interface{}
+}
+
+func newAWSAuthConfigMap() *awsAuthConfigMap {
+	return &awsAuthConfigMap{
+		raw: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata": map[string]interface{}{
+				"name":      awsAuthConfigMapName,
+				"namespace": awsAuthConfigMapNamespace,
+			},
+			"data": map[string]interface{}{},
+		},
+	}
+}
+
+func (m *awsAuthConfigMap) data() map[string]interface{} {
+	data, ok := m.raw["data"].(map[string]interface{})
+
+	if !ok {
+		data = make(map[string]interface{})
+		m.raw["data"] = data
+	}
+
+	return data
+}
+
+func (m *awsAuthConfigMap) entries(isRole bool) ([]yaml.MapSlice, error) {
+	key := awsAuthListKey(isRole)
+	v, _ := m.data()[key].(string)
+
+	var entries []yaml.MapSlice
+
+	if err := yaml.Unmarshal([]byte(v), &entries); err != nil {
+		return nil, fmt.Errorf("error parsing aws-auth ConfigMap %s: %w", key, err)
+	}
+
+	return entries, nil
+}
+
+func (m *awsAuthConfigMap) setEntries(isRole bool, entries []yaml.MapSlice) error {
+	key := awsAuthListKey(isRole)
+
+	if len(entries) == 0 {
+		delete(m.data(), key)
+		return nil
+	}
+
+	b, err := yaml.Marshal(entries)
+
+	if err != nil {
+		return fmt.Errorf("error encoding aws-auth ConfigMap %s: %w", key, err)
+	}
+
+	m.data()[key] = string(b)
+
+	return nil
+}
+
+func awsAuthListKey(isRole bool) string {
+	if isRole {
+		return awsAuthMapRolesKey
+	}
+
+	return awsAuthMapUsersKey
+}
+
+func awsAuthARNKey(isRole bool) string {
+	if isRole {
+		return "rolearn"
+	}
+
+	return "userarn"
+}
+
+func identityMappingFromEntry(entry yaml.MapSlice, isRole bool) *IdentityMapping {
+	mapping := &IdentityMapping{}
+
+	for _, item := range entry {
+		switch item.Key {
+		case awsAuthARNKey(isRole):
+			mapping.ARN, _ = item.Value.(string)
+		case "username":
+			mapping.Username, _ = item.Value.(string)
+		case "groups":
+			if groups, ok := item.Value.([]interface{}); ok {
+				for _, g := range groups {
+					if s, ok := g.(string); ok {
+						mapping.Groups = append(mapping.Groups, s)
+					}
+				}
+			}
+		}
+	}
+
+	if mapping.ARN == "" {
+		return nil
+	}
+
+	return mapping
+}
+
+func identityMappingToEntry(mapping *IdentityMapping, isRole bool) yaml.MapSlice {
+	entry := yaml.MapSlice{
+		{Key: awsAuthARNKey(isRole), Value: mapping.ARN},
+		{Key: "username", Value: mapping.Username},
+	}
+
+	if len(mapping.Groups) > 0 {
+		entry = append(entry, yaml.MapItem{Key: "groups", Value: mapping.Groups})
+	}
+
+	return entry
+}
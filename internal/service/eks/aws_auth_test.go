@@ -0,0 +1,418 @@
+package eks
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/nij4t/terraform-provider-aws/internal/tfresource"
+)
+
+const testFakeKubernetesToken = "k8s-aws-v1.test"
+
+// fakeKubernetesAPI serves just enough of the Kubernetes ConfigMap API to exercise AWSAuthClient.
+type fakeKubernetesAPI struct {
+	mu sync.Mutex
+
+	configMap       map[string]interface{}
+	resourceVersion int
+
+	// conflicts is the number of upcoming writes to reject after a concurrent writer bumps resourceVersion.
+	conflicts int
+	puts      int
+}
+
+func (f *fakeKubernetesAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if r.Header.Get("Authorization") != "Bearer "+testFakeKubernetesToken {
+		f.writeStatus(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	const collection = "/api/v1/namespaces/kube-system/configmaps"
+
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == collection+"/aws-auth":
+		if f.configMap == nil {
+			f.writeStatus(w, http.StatusNotFound, `configmaps "aws-auth" not found`)
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(f.configMap)
+	case r.Method == http.MethodPost && r.URL.Path == collection:
+		if f.configMap != nil {
+			f.writeStatus(w, http.StatusConflict, `configmaps "aws-auth" already exists`)
+			return
+		}
+
+		f.write(w, r)
+	case r.Method == http.MethodPut && r.URL.Path == collection+"/aws-auth":
+		f.puts++
+
+		if f.configMap == nil {
+			f.writeStatus(w, http.StatusNotFound, `configmaps "aws-auth" not found`)
+			return
+		}
+
+		if f.conflicts > 0 {
+			f.conflicts--
+			f.resourceVersion++
+			f.configMap["metadata"].(map[string]interface{})["resourceVersion"] = strconv.Itoa(f.resourceVersion)
+		}
+
+		f.write(w, r)
+	default:
+		f.writeStatus(w, http.StatusNotFound, "not found")
+	}
+}
+
+func (f *fakeKubernetesAPI) write(w http.ResponseWriter, r *http.Request) {
+	b, err := io.ReadAll(r.Body)
+
+	if err != nil {
+		f.writeStatus(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var configMap map[string]interface{}
+
+	if err := json.Unmarshal(b, &configMap); err != nil {
+		f.writeStatus(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	metadata, _ := configMap["metadata"].(map[string]interface{})
+
+	if f.configMap != nil {
+		if v, _ := metadata["resourceVersion"].(string); v != strconv.Itoa(f.resourceVersion) {
+			f.writeStatus(w, http.StatusConflict, "the object has been modified; please apply your changes to the latest version and try again")
+			return
+		}
+	}
+
+	f.resourceVersion++
+	metadata["resourceVersion"] = strconv.Itoa(f.resourceVersion)
+	f.configMap = configMap
+
+	_ = json.NewEncoder(w).Encode(f.configMap)
+}
+
+func (f *fakeKubernetesAPI) writeStatus(w http.ResponseWriter, code int, message string) {
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"kind":    "Status",
+		"code":    code,
+		"message": message,
+	})
+}
+
+func (f *fakeKubernetesAPI) data(t *testing.T) map[string]interface{} {
+	t.Helper()
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.configMap == nil {
+		t.Fatal("expected aws-auth ConfigMap to exist")
+	}
+
+	data, _ := f.configMap["data"].(map[string]interface{})
+
+	return data
+}
+
+func newTestAWSAuthClient(t *testing.T, api *fakeKubernetesAPI) *AWSAuthClient {
+	t.Helper()
+
+	server := httptest.NewTLSServer(api)
+	t.Cleanup(server.Close)
+
+	caData := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	client, err := NewAWSAuthClient(server.URL, caData, testFakeKubernetesToken)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return client
+}
+
+func TestAWSAuthClient_createConfigMap(t *testing.T) {
+	api := &fakeKubernetesAPI{}
+	client := newTestAWSAuthClient(t, api)
+	ctx := context.Background()
+
+	roleARN := "arn:aws:iam::123456789012:role/test" //lintignore:AWSAT005
+
+	_, err := client.FindIdentityMapping(ctx, roleARN, true)
+
+	if !tfresource.NotFound(err) {
+		t.Fatalf("expected NotFound error, got: %v", err)
+	}
+
+	mapping := &IdentityMapping{
+		ARN:      roleARN,
+		Username: "test",
+		Groups:   []string{"system:masters"},
+	}
+
+	if err := client.PutIdentityMapping(ctx, mapping, true); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got, err := client.FindIdentityMapping(ctx, roleARN, true)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !reflect.DeepEqual(got, mapping) {
+		t.Errorf("got %#v, expected %#v", got, mapping)
+	}
+
+	if _, ok := api.data(t)["mapUsers"]; ok {
+		t.Error("expected mapUsers to be absent")
+	}
+}
+
+func TestAWSAuthClient_preservesOtherEntries(t *testing.T) {
+	api := &fakeKubernetesAPI{
+		resourceVersion: 1,
+		configMap: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata": map[string]interface{}{
+				"name":            "aws-auth",
+				"namespace":       "kube-system",
+				"resourceVersion": "1",
+				"labels":          map[string]interface{}{"owner": "nodes"},
+			},
+			"data": map[string]interface{}{
+				"mapRoles": `- rolearn: arn:aws:iam::123456789012:role/nodes
+  username: system:node:{{EC2PrivateDNSName}}
+  groups:
+    - system:bootstrappers
+    - system:nodes
+`,
+				"mapAccounts": "- \"123456789012\"\n",
+			},
+		},
+	}
+	client := newTestAWSAuthClient(t, api)
+	ctx := context.Background()
+
+	roleARN := "arn:aws:iam::123456789012:role/team"  //lintignore:AWSAT005
+	userARN := "arn:aws:iam::123456789012:user/alice" //lintignore:AWSAT005
+
+	if err := client.PutIdentityMapping(ctx, &IdentityMapping{ARN: roleARN, Username: "team", Groups: []string{"team"}}, true); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := client.PutIdentityMapping(ctx, &IdentityMapping{ARN: userARN, Username: "alice"}, false); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Update in place.
+	if err := client.PutIdentityMapping(ctx, &IdentityMapping{ARN: roleARN, Username: "team-admin", Groups: []string{"team", "admins"}}, true); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	nodes, err := client.FindIdentityMapping(ctx, "arn:aws:iam::123456789012:role/nodes", true) //lintignore:AWSAT005
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := []string{"system:bootstrappers", "system:nodes"}; nodes.Username != "system:node:{{EC2PrivateDNSName}}" || !reflect.DeepEqual(nodes.Groups, expected) {
+		t.Errorf("unmanaged entry was modified: %#v", nodes)
+	}
+
+	team, err := client.FindIdentityMapping(ctx, roleARN, true)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := []string{"team", "admins"}; team.Username != "team-admin" || !reflect.DeepEqual(team.Groups, expected) {
+		t.Errorf("unexpected entry: %#v", team)
+	}
+
+	if got := strings.Count(api.data(t)["mapRoles"].(string), "rolearn:"); got != 2 {
+		t.Errorf("expected 2 mapRoles entries, got %d", got)
+	}
+
+	if _, err := client.FindIdentityMapping(ctx, userARN, true); !tfresource.NotFound(err) {
+		t.Errorf("expected user not to be found in mapRoles, got: %v", err)
+	}
+
+	if err := client.DeleteIdentityMapping(ctx, roleARN, true); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := client.DeleteIdentityMapping(ctx, userARN, false); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	data := api.data(t)
+
+	if got := strings.Count(data["mapRoles"].(string), "rolearn:"); got != 1 {
+		t.Errorf("expected 1 mapRoles entry, got %d", got)
+	}
+
+	if _, ok := data["mapUsers"]; ok {
+		t.Error("expected mapUsers to be removed")
+	}
+
+	if got, expected := data["mapAccounts"], "- \"123456789012\"\n"; got != expected {
+		t.Errorf("mapAccounts was modified: %q", got)
+	}
+
+	labels, _ := api.configMap["metadata"].(map[string]interface{})["labels"].(map[string]interface{})
+
+	if labels["owner"] != "nodes" {
+		t.Errorf("metadata labels were not preserved: %#v", labels)
+	}
+}
+
+func TestAWSAuthClient_retriesOnConflict(t *testing.T) {
+	api := &fakeKubernetesAPI{}
+	client := newTestAWSAuthClient(t, api)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		mapping := &IdentityMapping{
+			ARN:      fmt.Sprintf("arn:aws:iam::123456789012:role/test-%d", i), //lintignore:AWSAT005
+			Username: fmt.Sprintf("test-%d", i),
+		}
+
+		if err := client.PutIdentityMapping(ctx, mapping, true); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	api.mu.Lock()
+	api.conflicts = 1
+	api.puts = 0
+	api.mu.Unlock()
+
+	if err := client.DeleteIdentityMapping(ctx, "arn:aws:iam::123456789012:role/test-0", true); err != nil { //lintignore:AWSAT005
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if api.puts != 2 {
+		t.Errorf("expected 2 writes, got %d", api.puts)
+	}
+
+	if got := strings.Count(api.data(t)["mapRoles"].(string), "rolearn:"); got != 1 {
+		t.Errorf("expected 1 mapRoles entry, got %d", got)
+	}
+}
+
+func TestAWSAuthClient_unauthorized(t *testing.T) {
+	api := &fakeKubernetesAPI{}
+	server := httptest.NewTLSServer(api)
+	defer server.Close()
+
+	caData := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	client, err := NewAWSAuthClient(server.URL, caData, "wrong")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	_, err = client.FindIdentityMapping(context.Background(), "arn:aws:iam::123456789012:role/test", true) //lintignore:AWSAT005
+
+	if err == nil || tfresource.NotFound(err) || !strings.Contains(err.Error(), "401") {
+		t.Errorf("expected HTTP 401 error, got: %v", err)
+	}
+}
+
+func TestIdentityMappingIsRole(t *testing.T) {
+	testCases := []struct {
+		ARN      string
+		Expected bool
+	}{
+		{"arn:aws:iam::123456789012:role/test", true},         //lintignore:AWSAT005
+		{"arn:aws:iam::123456789012:role/path/to/test", true}, //lintignore:AWSAT005
+		{"arn:aws:iam::123456789012:user/test", false},        //lintignore:AWSAT005
+	}
+
+	for _, testCase := range testCases {
+		got, err := identityMappingIsRole(testCase.ARN)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if got != testCase.Expected {
+			t.Errorf("%s: got %t, expected %t", testCase.ARN, got, testCase.Expected)
+		}
+	}
+}
+
+func TestAWSAuthIdentityARN(t *testing.T) {
+	testCases := []struct {
+		ARN      string
+		Expected string
+	}{
+		{"arn:aws:iam::123456789012:role/test", "arn:aws:iam::123456789012:role/test"},                 //lintignore:AWSAT005
+		{"arn:aws:iam::123456789012:role/path/to/test", "arn:aws:iam::123456789012:role/test"},         //lintignore:AWSAT005
+		{"arn:aws:iam::123456789012:user/path/to/test", "arn:aws:iam::123456789012:user/path/to/test"}, //lintignore:AWSAT005
+	}
+
+	for _, testCase := range testCases {
+		if got := awsAuthIdentityARN(testCase.ARN); got != testCase.Expected {
+			t.Errorf("%s: got %s, expected %s", testCase.ARN, got, testCase.Expected)
+		}
+	}
+}
+
+func TestNewAWSAuthClient_reusesHTTPClient(t *testing.T) {
+	server := httptest.NewTLSServer(&fakeKubernetesAPI{})
+	t.Cleanup(server.Close)
+
+	caData := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	client, err := NewAWSAuthClient(server.URL, caData, testFakeKubernetesToken)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	same, err := NewAWSAuthClient(server.URL+"/", caData, "k8s-aws-v1.other")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if same.httpClient != client.httpClient {
+		t.Error("expected the HTTP client to be reused for the same cluster")
+	}
+
+	if same.token == client.token {
+		t.Error("expected each client to use its own token")
+	}
+
+	other, err := NewAWSAuthClient(server.URL, nil, testFakeKubernetesToken)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if other.httpClient == client.httpClient {
+		t.Error("expected a different HTTP client for a different certificate authority")
+	}
+}
@@ -80,3 +80,23 @@ func NodeGroupParseResourceID(id string) (string, string, error) {
 
 	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected cluster-name%[2]snode-group-name", id, nodeGroupResourceIDSeparator)
 }
+
+const identityMappingResourceIDSeparator = ":"
+
+func IdentityMappingCreateResourceID(clusterName, identityARN string) string {
+	parts := []string{clusterName, identityARN}
+	id := strings.Join(parts, identityMappingResourceIDSeparator)
+
+	return id
+}
+
+func IdentityMappingParseResourceID(id string) (string, string, error) {
+	// The IAM ARN itself contains the separator.
+	parts := strings.SplitN(id, identityMappingResourceIDSeparator, 2)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected cluster-name%[2]siam-arn", id, identityMappingResourceIDSeparator)
+}
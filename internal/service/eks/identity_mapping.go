@@ -0,0 +1,307 @@
+package eks
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nij4t/terraform-provider-aws/internal/conns"
+	"github.com/nij4t/terraform-provider-aws/internal/flex"
+	"github.com/nij4t/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceIdentityMapping() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceIdentityMappingCreate,
+		ReadWithoutTimeout:   resourceIdentityMappingRead,
+		UpdateWithoutTimeout: resourceIdentityMappingUpdate,
+		DeleteWithoutTimeout: resourceIdentityMappingDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"groups": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+			},
+
+			"role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validIdentityMappingRoleARN,
+				ExactlyOneOf: []string{"role_arn", "user_arn"},
+			},
+
+			"user_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validIdentityMappingUserARN,
+				ExactlyOneOf: []string{"role_arn", "user_arn"},
+			},
+
+			"username": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceIdentityMappingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EKSConn
+	stsConn := meta.(*conns.AWSClient).STSConn
+
+	clusterName := d.Get("cluster_name").(string)
+	identityARN := identityMappingARN(d)
+	isRole, err := identityMappingIsRole(identityARN)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := IdentityMappingCreateResourceID(clusterName, identityARN)
+
+	client, err := NewAWSAuthClientForCluster(conn, stsConn, clusterName)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Refuse to silently take over an entry that is managed elsewhere.
+	_, err = client.FindIdentityMapping(ctx, awsAuthIdentityARN(identityARN), isRole)
+
+	if err == nil {
+		return diag.Errorf("EKS Identity Mapping (%s) already exists in the aws-auth ConfigMap, import it instead", id)
+	}
+
+	if !tfresource.NotFound(err) {
+		return diag.Errorf("error reading EKS Identity Mapping (%s): %s", id, err)
+	}
+
+	log.Printf("[DEBUG] Creating EKS Identity Mapping: %s", id)
+	if err := client.PutIdentityMapping(ctx, expandIdentityMapping(d, identityARN), isRole); err != nil {
+		return diag.Errorf("error creating EKS Identity Mapping (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceIdentityMappingRead(ctx, d, meta)
+}
+
+func resourceIdentityMappingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EKSConn
+	stsConn := meta.(*conns.AWSClient).STSConn
+
+	clusterName, identityARN, err := IdentityMappingParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	isRole, err := identityMappingIsRole(identityARN)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client, err := NewAWSAuthClientForCluster(conn, stsConn, clusterName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EKS Cluster (%s) not found, removing Identity Mapping (%s) from state", clusterName, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mapping, err := client.FindIdentityMapping(ctx, awsAuthIdentityARN(identityARN), isRole)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EKS Identity Mapping (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading EKS Identity Mapping (%s): %s", d.Id(), err)
+	}
+
+	d.Set("cluster_name", clusterName)
+	d.Set("groups", mapping.Groups)
+
+	// Role ARNs are mapped without their path.
+	if isRole {
+		d.Set("role_arn", identityARN)
+		d.Set("user_arn", nil)
+	} else {
+		d.Set("role_arn", nil)
+		d.Set("user_arn", identityARN)
+	}
+
+	d.Set("username", mapping.Username)
+
+	return nil
+}
+
+func resourceIdentityMappingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EKSConn
+	stsConn := meta.(*conns.AWSClient).STSConn
+
+	clusterName := d.Get("cluster_name").(string)
+	identityARN := identityMappingARN(d)
+	isRole, err := identityMappingIsRole(identityARN)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client, err := NewAWSAuthClientForCluster(conn, stsConn, clusterName)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Updating EKS Identity Mapping: %s", d.Id())
+	if err := client.PutIdentityMapping(ctx, expandIdentityMapping(d, identityARN), isRole); err != nil {
+		return diag.Errorf("error updating EKS Identity Mapping (%s): %s", d.Id(), err)
+	}
+
+	return resourceIdentityMappingRead(ctx, d, meta)
+}
+
+func resourceIdentityMappingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EKSConn
+	stsConn := meta.(*conns.AWSClient).STSConn
+
+	clusterName, identityARN, err := IdentityMappingParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	isRole, err := identityMappingIsRole(identityARN)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client, err := NewAWSAuthClientForCluster(conn, stsConn, clusterName)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting EKS Identity Mapping: %s", d.Id())
+	if err := client.DeleteIdentityMapping(ctx, awsAuthIdentityARN(identityARN), isRole); err != nil {
+		return diag.Errorf("error deleting EKS Identity Mapping (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// NewAWSAuthClientForCluster returns a client for the aws-auth ConfigMap of the named cluster,
+// authenticated as the caller's IAM identity.
+func NewAWSAuthClientForCluster(conn *eks.EKS, stsConn *sts.STS, clusterName string) (*AWSAuthClient, error) {
+	cluster, err := FindClusterByName(conn, clusterName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var caData []byte
+
+	if cluster.CertificateAuthority != nil {
+		caData, err = base64.StdEncoding.DecodeString(aws.StringValue(cluster.CertificateAuthority.Data))
+
+		if err != nil {
+			return nil, fmt.Errorf("error decoding EKS Cluster (%s) certificate authority data: %w", clusterName, err)
+		}
+	}
+
+	generator, err := NewGenerator(false, false)
+
+	if err != nil {
+		return nil, fmt.Errorf("error getting token generator: %w", err)
+	}
+
+	token, err := generator.GetWithSTS(clusterName, stsConn)
+
+	if err != nil {
+		return nil, fmt.Errorf("error getting EKS Cluster (%s) token: %w", clusterName, err)
+	}
+
+	return NewAWSAuthClient(aws.StringValue(cluster.Endpoint), caData, token.Token)
+}
+
+func identityMappingARN(d *schema.ResourceData) string {
+	if v, ok := d.GetOk("role_arn"); ok {
+		return v.(string)
+	}
+
+	return d.Get("user_arn").(string)
+}
+
+// identityMappingIsRole reports whether an IAM ARN belongs in mapRoles rather than mapUsers.
+func identityMappingIsRole(identityARN string) (bool, error) {
+	parsedARN, err := arn.Parse(identityARN)
+
+	if err != nil {
+		return false, fmt.Errorf("error parsing IAM ARN (%s): %w", identityARN, err)
+	}
+
+	return strings.HasPrefix(parsedARN.Resource, "role/"), nil
+}
+
+// awsAuthIdentityARN returns the ARN of an IAM identity as mapped in the aws-auth ConfigMap.
+// The ConfigMap only matches role ARNs without their path, e.g. role/name for role/path/name.
+func awsAuthIdentityARN(identityARN string) string {
+	parsedARN, err := arn.Parse(identityARN)
+
+	if err != nil || !strings.HasPrefix(parsedARN.Resource, "role/") {
+		return identityARN
+	}
+
+	parts := strings.Split(parsedARN.Resource, "/")
+	parsedARN.Resource = "role/" + parts[len(parts)-1]
+
+	return parsedARN.String()
+}
+
+func expandIdentityMapping(d *schema.ResourceData, identityARN string) *IdentityMapping {
+	return &IdentityMapping{
+		ARN:      awsAuthIdentityARN(identityARN),
+		Username: d.Get("username").(string),
+		Groups:   aws.StringValueSlice(flex.ExpandStringSet(d.Get("groups").(*schema.Set))),
+	}
+}
@@ -0,0 +1,205 @@
+package eks_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/eks"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/nij4t/terraform-provider-aws/internal/acctest"
+	"github.com/nij4t/terraform-provider-aws/internal/conns"
+	tfeks "github.com/nij4t/terraform-provider-aws/internal/service/eks"
+	"github.com/nij4t/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccEKSIdentityMapping_basic(t *testing.T) {
+	var mapping tfeks.IdentityMapping
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_identity_mapping.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, eks.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckIdentityMappingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityMappingConfig_Role(rName, `["team"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityMappingExists(resourceName, &mapping),
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_name", "aws_eks_cluster.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "groups.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "groups.*", "team"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.team", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "user_arn", ""),
+					resource.TestCheckResourceAttr(resourceName, "username", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccIdentityMappingConfig_Role(rName, `["team", "admins"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityMappingExists(resourceName, &mapping),
+					resource.TestCheckResourceAttr(resourceName, "groups.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "groups.*", "team"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "groups.*", "admins"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEKSIdentityMapping_user(t *testing.T) {
+	var mapping tfeks.IdentityMapping
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_identity_mapping.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, eks.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckIdentityMappingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityMappingConfig_User(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityMappingExists(resourceName, &mapping),
+					resource.TestCheckResourceAttr(resourceName, "groups.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "role_arn", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "user_arn", "aws_iam_user.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "username", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIdentityMappingExists(resourceName string, mapping *tfeks.IdentityMapping) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EKS Identity Mapping ID is set")
+		}
+
+		client := acctest.Provider.Meta().(*conns.AWSClient)
+
+		clusterName, identityARN, err := tfeks.IdentityMappingParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		authClient, err := tfeks.NewAWSAuthClientForCluster(client.EKSConn, client.STSConn, clusterName)
+
+		if err != nil {
+			return err
+		}
+
+		output, err := authClient.FindIdentityMapping(context.Background(), identityARN, rs.Primary.Attributes["role_arn"] != "")
+
+		if err != nil {
+			return err
+		}
+
+		*mapping = *output
+
+		return nil
+	}
+}
+
+func testAccCheckIdentityMappingDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_eks_identity_mapping" {
+			continue
+		}
+
+		client := acctest.Provider.Meta().(*conns.AWSClient)
+
+		clusterName, identityARN, err := tfeks.IdentityMappingParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		authClient, err := tfeks.NewAWSAuthClientForCluster(client.EKSConn, client.STSConn, clusterName)
+
+		// The mapping goes away with its cluster.
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		_, err = authClient.FindIdentityMapping(context.Background(), identityARN, rs.Primary.Attributes["role_arn"] != "")
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EKS Identity Mapping %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccIdentityMappingConfig_Role(rName, groups string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_Required(rName), fmt.Sprintf(`
+resource "aws_iam_role" "team" {
+  name = "%[1]s-team"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action    = "sts:AssumeRole"
+      Effect    = "Allow"
+      Principal = { AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root" }
+    }]
+  })
+}
+
+data "aws_caller_identity" "current" {}
+
+resource "aws_eks_identity_mapping" "test" {
+  cluster_name = aws_eks_cluster.test.name
+  role_arn     = aws_iam_role.team.arn
+  username     = %[1]q
+  groups       = %[2]s
+}
+`, rName, groups))
+}
+
+func testAccIdentityMappingConfig_User(rName string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_Required(rName), fmt.Sprintf(`
+resource "aws_iam_user" "test" {
+  name = %[1]q
+}
+
+resource "aws_eks_identity_mapping" "test" {
+  cluster_name = aws_eks_cluster.test.name
+  user_arn     = aws_iam_user.test.arn
+  username     = %[1]q
+}
+`, rName))
+}
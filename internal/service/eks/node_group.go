@@ -290,7 +290,7 @@ func resourceNodeGroupCreate(ctx context.Context, d *schema.ResourceData, meta i
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
 	clusterName := d.Get("cluster_name").(string)
-	nodeGroupName := create.Name(d.Get("node_group_name").(string), d.Get("node_group_name_prefix").(string))
+	nodeGroupName := create.Name(meta.(*conns.AWSClient).NamingPolicy, d.Get("node_group_name").(string), d.Get("node_group_name_prefix").(string))
 	id := NodeGroupCreateResourceID(clusterName, nodeGroupName)
 
 	input := &eks.CreateNodegroupInput{
@@ -3,6 +3,9 @@ package eks
 import (
 	"fmt"
 	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
 )
 
 func validClusterName(v interface{}, k string) (ws []string, errors []error) {
@@ -22,3 +25,30 @@ func validClusterName(v interface{}, k string) (ws []string, errors []error) {
 
 	return
 }
+
+// validIdentityMappingRoleARN validates an IAM role ARN, which is mapped in the mapRoles section of the aws-auth ConfigMap.
+func validIdentityMappingRoleARN(v interface{}, k string) (ws []string, errors []error) {
+	return validIAMIdentityARN(v, k, "role/")
+}
+
+// validIdentityMappingUserARN validates an IAM user ARN, which is mapped in the mapUsers section of the aws-auth ConfigMap.
+func validIdentityMappingUserARN(v interface{}, k string) (ws []string, errors []error) {
+	return validIAMIdentityARN(v, k, "user/")
+}
+
+func validIAMIdentityARN(v interface{}, k string, resourcePrefix string) (ws []string, errors []error) {
+	value := v.(string)
+
+	parsedARN, err := arn.Parse(value)
+
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: %s", k, value, err))
+		return
+	}
+
+	if parsedARN.Service != "iam" || !strings.HasPrefix(parsedARN.Resource, resourcePrefix) {
+		errors = append(errors, fmt.Errorf("%q (%s) must be an IAM ARN with a %q resource", k, value, resourcePrefix))
+	}
+
+	return
+}
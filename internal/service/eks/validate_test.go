@@ -57,3 +57,55 @@ func TestValidClusterName(t *testing.T) {
 		}
 	}
 }
+
+func TestValidIdentityMappingRoleARN(t *testing.T) {
+	validARNs := []string{
+		"arn:aws:iam::123456789012:role/example",
+		"arn:aws:iam::123456789012:role/path/example",
+		"arn:aws-us-gov:iam::123456789012:role/example",
+	}
+
+	for _, v := range validARNs {
+		if _, errors := validIdentityMappingRoleARN(v, "role_arn"); len(errors) != 0 {
+			t.Errorf("%q should be a valid role ARN: %q", v, errors)
+		}
+	}
+
+	invalidARNs := []string{
+		"example",
+		"arn:aws:iam::123456789012:user/example",
+		"arn:aws:sts::123456789012:assumed-role/example/session",
+		"arn:aws:s3:::role/example",
+	}
+
+	for _, v := range invalidARNs {
+		if _, errors := validIdentityMappingRoleARN(v, "role_arn"); len(errors) == 0 {
+			t.Errorf("%q should be an invalid role ARN", v)
+		}
+	}
+}
+
+func TestValidIdentityMappingUserARN(t *testing.T) {
+	validARNs := []string{
+		"arn:aws:iam::123456789012:user/example",
+		"arn:aws:iam::123456789012:user/path/example",
+	}
+
+	for _, v := range validARNs {
+		if _, errors := validIdentityMappingUserARN(v, "user_arn"); len(errors) != 0 {
+			t.Errorf("%q should be a valid user ARN: %q", v, errors)
+		}
+	}
+
+	invalidARNs := []string{
+		"example",
+		"arn:aws:iam::123456789012:role/example",
+		"arn:aws:iam::123456789012:root",
+	}
+
+	for _, v := range invalidARNs {
+		if _, errors := validIdentityMappingUserARN(v, "user_arn"); len(errors) == 0 {
+			t.Errorf("%q should be an invalid user ARN", v)
+		}
+	}
+}
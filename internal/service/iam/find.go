@@ -1,7 +1,9 @@
 package iam
 
 import (
+	"context"
 	"regexp"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iam"
@@ -171,3 +173,33 @@ func FindRoleByName(conn *iam.IAM, name string) (*iam.Role, error) {
 
 	return output.Role, nil
 }
+
+// serviceLinkedRolePathPrefix is the path of roles that are created and managed by AWS services.
+const serviceLinkedRolePathPrefix = "/aws-service-role/"
+
+// FindRoleNames returns the names of all roles, excluding service-linked roles.
+func FindRoleNames(ctx context.Context, conn *iam.IAM) ([]string, error) {
+	var names []string
+
+	err := conn.ListRolesPagesWithContext(ctx, &iam.ListRolesInput{}, func(page *iam.ListRolesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, role := range page.Roles {
+			if role == nil || strings.HasPrefix(aws.StringValue(role.Path), serviceLinkedRolePathPrefix) {
+				continue
+			}
+
+			names = append(names, aws.StringValue(role.RoleName))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return names, nil
+}
@@ -220,3 +220,62 @@ func iamPolicyDecodeConfigStringList(lI []interface{}) interface{} {
 	sort.Sort(sort.Reverse(sort.StringSlice(ret)))
 	return ret
 }
+
+// NormalizePolicyDocument round-trips a policy document through IAMPolicyDoc,
+// producing principals and conditions in the same form that the
+// aws_iam_policy_document data source emits.
+// Documents with condition values that IAMPolicyDoc does not decode, e.g. booleans, return an error.
+func NormalizePolicyDocument(policy string) (string, error) {
+	if err := checkPolicyConditionValues(policy); err != nil {
+		return "", err
+	}
+
+	doc := &IAMPolicyDoc{}
+
+	if err := json.Unmarshal([]byte(policy), doc); err != nil {
+		return "", fmt.Errorf("error parsing policy document: %w", err)
+	}
+
+	b, err := json.Marshal(doc)
+
+	if err != nil {
+		return "", fmt.Errorf("error serializing policy document: %w", err)
+	}
+
+	return string(b), nil
+}
+
+// checkPolicyConditionValues returns an error if a statement condition value of a policy document
+// is neither a string nor a list of strings.
+func checkPolicyConditionValues(policy string) error {
+	var doc struct {
+		Statements []struct {
+			Condition map[string]map[string]interface{}
+		} `json:"Statement"`
+	}
+
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+		return fmt.Errorf("error parsing policy document: %w", err)
+	}
+
+	for _, statement := range doc.Statements {
+		for test, variables := range statement.Condition {
+			for variable, values := range variables {
+				switch values := values.(type) {
+				case string:
+					continue
+				case []interface{}:
+					for _, v := range values {
+						if _, ok := v.(string); !ok {
+							return fmt.Errorf("unsupported data type %T for condition %s %s value", v, test, variable)
+						}
+					}
+				default:
+					return fmt.Errorf("unsupported data type %T for condition %s %s value", values, test, variable)
+				}
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package iam_test
+
+import (
+	"testing"
+
+	tfiam "github.com/nij4t/terraform-provider-aws/internal/service/iam"
+)
+
+func TestNormalizePolicyDocument(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Policy        string
+		Expected      string
+		ExpectedError bool
+	}{
+		{
+			Name:          "invalid JSON",
+			Policy:        `{`,
+			ExpectedError: true,
+		},
+		{
+			Name:     "wildcard principal",
+			Policy:   `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Principal":{"*":"*"}}]}`,
+			Expected: `{"Version":"2012-10-17","Statement":[{"Sid":"","Effect":"Allow","Action":"s3:GetObject","Resource":"*","Principal":"*"}]}`,
+		},
+		{
+			Name:     "condition",
+			Policy:   `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":["s3:*"],"Resource":"*","Condition":{"Bool":{"aws:SecureTransport":"false"}}}]}`,
+			Expected: `{"Version":"2012-10-17","Statement":[{"Sid":"","Effect":"Deny","Action":["s3:*"],"Resource":"*","Condition":{"Bool":{"aws:SecureTransport":["false"]}}}]}`,
+		},
+		{
+			Name:          "boolean condition",
+			Policy:        `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":["s3:*"],"Resource":"*","Condition":{"Bool":{"aws:SecureTransport":false}}}]}`,
+			ExpectedError: true,
+		},
+		{
+			Name:          "numeric condition list",
+			Policy:        `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:*"],"Resource":"*","Condition":{"NumericLessThan":{"aws:MultiFactorAuthAge":[3600]}}}]}`,
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := tfiam.NormalizePolicyDocument(testCase.Policy)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package iam
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nij4t/terraform-provider-aws/internal/conns"
+)
+
+// FindPolicyValidationFindings normalizes a policy document and returns the
+// IAM Access Analyzer findings for it.
+// Documents that can't be normalized are validated as written, so that
+// Access Analyzer reports any syntax errors.
+func FindPolicyValidationFindings(conn *accessanalyzer.AccessAnalyzer, input *accessanalyzer.ValidatePolicyInput) ([]*accessanalyzer.ValidatePolicyFinding, error) {
+	if policy, err := NormalizePolicyDocument(aws.StringValue(input.PolicyDocument)); err != nil {
+		log.Printf("[DEBUG] Validating policy document as written: %s", err)
+	} else {
+		input.PolicyDocument = aws.String(policy)
+	}
+
+	var output []*accessanalyzer.ValidatePolicyFinding
+
+	err := conn.ValidatePolicyPages(input, func(page *accessanalyzer.ValidatePolicyOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, finding := range page.Findings {
+			if finding == nil {
+				continue
+			}
+
+			output = append(output, finding)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// PolicyValidationCustomizeDiff returns a CustomizeDiffFunc that, when the
+// provider's policy_validation setting is enabled, runs IAM Access Analyzer
+// policy validation on the planned value of the given attribute and fails the
+// plan on any ERROR or SECURITY_WARNING findings.
+// Policy documents that can't be validated, e.g. without permission to call
+// the ValidatePolicy API, are logged and don't fail the plan.
+func PolicyValidationCustomizeDiff(key, policyType string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		client := meta.(*conns.AWSClient)
+
+		if !client.PolicyValidation {
+			return nil
+		}
+
+		if !diff.HasChange(key) || !diff.NewValueKnown(key) {
+			return nil
+		}
+
+		policy := diff.Get(key).(string)
+
+		if policy == "" {
+			return nil
+		}
+
+		findings, err := FindPolicyValidationFindings(client.AccessAnalyzerConn, &accessanalyzer.ValidatePolicyInput{
+			PolicyDocument: aws.String(policy),
+			PolicyType:     aws.String(policyType),
+		})
+
+		if err != nil {
+			log.Printf("[WARN] Unable to validate %s with IAM Access Analyzer: %s", key, err)
+
+			return nil
+		}
+
+		var problems []string
+
+		for _, finding := range findings {
+			switch findingType := aws.StringValue(finding.FindingType); findingType {
+			case accessanalyzer.ValidatePolicyFindingTypeError, accessanalyzer.ValidatePolicyFindingTypeSecurityWarning:
+				problems = append(problems, fmt.Sprintf("  %s %s: %s", findingType, aws.StringValue(finding.IssueCode), aws.StringValue(finding.FindingDetails)))
+			}
+		}
+
+		if len(problems) == 0 {
+			return nil
+		}
+
+		return fmt.Errorf("IAM Access Analyzer reported findings for %s:\n\n%s", key, strings.Join(problems, "\n"))
+	}
+}
@@ -0,0 +1,456 @@
+package iam
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nij4t/terraform-provider-aws/internal/conns"
+	"github.com/nij4t/terraform-provider-aws/internal/create"
+	"github.com/nij4t/terraform-provider-aws/internal/flex"
+	"github.com/nij4t/terraform-provider-aws/internal/verify"
+)
+
+func DataSourcePrincipalPolicySimulation() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePrincipalPolicySimulationRead,
+
+		Schema: map[string]*schema.Schema{
+			"action_names": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"all_allowed": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"caller_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"context": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(iam.ContextKeyTypeEnum_Values(), false),
+						},
+						"values": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"expected_decisions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"decision": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(PolicySimulationExpectedDecision_Values(), false),
+						},
+						"resource_arn": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"permissions_boundary_policies_json": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsJSON,
+				},
+			},
+			"policy_source_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+				AtLeastOneOf: []string{"policy_source_arn", "policies_json"},
+			},
+			"policies_json": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsJSON,
+				},
+				AtLeastOneOf: []string{"policy_source_arn", "policies_json"},
+			},
+			"resource_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"resource_handling_option": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(PolicySimulationResourceHandlingOption_Values(), false),
+			},
+			"resource_owner_account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"resource_policy_json": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"allowed": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"decision": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"decision_details": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"matched_statements": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"source_policy_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"source_policy_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"missing_context_keys": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"resource_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePrincipalPolicySimulationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	var results []*iam.EvaluationResult
+	var err error
+	var id string
+
+	appendResults := func(page *iam.SimulatePolicyResponse, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		results = append(results, page.EvaluationResults...)
+
+		return !lastPage
+	}
+
+	input := expandSimulateCustomPolicyInput(d, meta)
+
+	if v, ok := d.GetOk("policy_source_arn"); ok {
+		principalInput := &iam.SimulatePrincipalPolicyInput{
+			ActionNames:                        input.ActionNames,
+			CallerArn:                          input.CallerArn,
+			ContextEntries:                     input.ContextEntries,
+			PermissionsBoundaryPolicyInputList: input.PermissionsBoundaryPolicyInputList,
+			PolicyInputList:                    input.PolicyInputList,
+			PolicySourceArn:                    aws.String(v.(string)),
+			ResourceArns:                       input.ResourceArns,
+			ResourceHandlingOption:             input.ResourceHandlingOption,
+			ResourceOwner:                      input.ResourceOwner,
+			ResourcePolicy:                     input.ResourcePolicy,
+		}
+
+		log.Printf("[DEBUG] Simulating IAM principal policy: %s", principalInput)
+		err = conn.SimulatePrincipalPolicyPages(principalInput, appendResults)
+
+		id = principalInput.String()
+	} else {
+		log.Printf("[DEBUG] Simulating IAM custom policy: %s", input)
+		err = conn.SimulateCustomPolicyPages(input, appendResults)
+
+		id = input.String()
+	}
+
+	if err != nil {
+		return fmt.Errorf("error simulating IAM policy: %w", err)
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(id)))
+
+	allAllowed := true
+	for _, result := range results {
+		if aws.StringValue(result.EvalDecision) != iam.PolicyEvaluationDecisionTypeAllowed {
+			allAllowed = false
+			break
+		}
+	}
+
+	d.Set("all_allowed", allAllowed)
+
+	if err := d.Set("results", flattenEvaluationResults(results)); err != nil {
+		return fmt.Errorf("error setting results: %w", err)
+	}
+
+	if v, ok := d.GetOk("expected_decisions"); ok && len(v.([]interface{})) > 0 {
+		if mismatches := UnexpectedPolicySimulationDecisions(results, expandPolicySimulationExpectations(v.([]interface{}))); len(mismatches) > 0 {
+			return fmt.Errorf("IAM policy simulation returned unexpected decisions:\n\n%s", strings.Join(mismatches, "\n"))
+		}
+	}
+
+	return nil
+}
+
+// PolicySimulationDecisionDenied is an expected decision matching both explicitDeny and implicitDeny.
+const PolicySimulationDecisionDenied = "denied"
+
+func PolicySimulationExpectedDecision_Values() []string {
+	return append(iam.PolicyEvaluationDecisionType_Values(), PolicySimulationDecisionDenied)
+}
+
+// PolicySimulationResourceHandlingOption_Values returns the EC2 scenarios that can be simulated.
+func PolicySimulationResourceHandlingOption_Values() []string {
+	return []string{
+		"EC2-Classic-InstanceStore",
+		"EC2-Classic-EBS",
+		"EC2-VPC-InstanceStore",
+		"EC2-VPC-InstanceStore-Subnet",
+		"EC2-VPC-EBS",
+		"EC2-VPC-EBS-Subnet",
+	}
+}
+
+// PolicySimulationExpectation is the decision expected for an action,
+// on a resource or, if ResourceARN is empty, on every simulated resource.
+type PolicySimulationExpectation struct {
+	ActionName  string
+	Decision    string
+	ResourceARN string
+}
+
+// UnexpectedPolicySimulationDecisions returns a description of each evaluation
+// result whose decision differs from the one expected for its action and resource,
+// and of each expectation without any evaluation result.
+// Results without an expectation are not checked.
+func UnexpectedPolicySimulationDecisions(results []*iam.EvaluationResult, expectations []PolicySimulationExpectation) []string {
+	var mismatches []string
+
+	for _, expectation := range expectations {
+		var found bool
+
+		for _, result := range results {
+			if result == nil {
+				continue
+			}
+
+			// IAM action names are case-insensitive.
+			if !strings.EqualFold(aws.StringValue(result.EvalActionName), expectation.ActionName) {
+				continue
+			}
+
+			if expectation.ResourceARN != "" && aws.StringValue(result.EvalResourceName) != expectation.ResourceARN {
+				continue
+			}
+
+			found = true
+
+			if decision := aws.StringValue(result.EvalDecision); !policySimulationDecisionMatches(decision, expectation.Decision) {
+				mismatches = append(mismatches, fmt.Sprintf("  %s on %s: %s, expected %s", aws.StringValue(result.EvalActionName), aws.StringValue(result.EvalResourceName), decision, expectation.Decision))
+			}
+		}
+
+		if !found {
+			resourceARN := expectation.ResourceARN
+
+			if resourceARN == "" {
+				resourceARN = "any resource"
+			}
+
+			mismatches = append(mismatches, fmt.Sprintf("  %s on %s: not simulated, expected %s", expectation.ActionName, resourceARN, expectation.Decision))
+		}
+	}
+
+	return mismatches
+}
+
+func policySimulationDecisionMatches(decision, expected string) bool {
+	if expected == PolicySimulationDecisionDenied {
+		return decision == iam.PolicyEvaluationDecisionTypeExplicitDeny || decision == iam.PolicyEvaluationDecisionTypeImplicitDeny
+	}
+
+	return decision == expected
+}
+
+func expandPolicySimulationExpectations(tfList []interface{}) []PolicySimulationExpectation {
+	var expectations []PolicySimulationExpectation
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		expectations = append(expectations, PolicySimulationExpectation{
+			ActionName:  tfMap["action_name"].(string),
+			Decision:    tfMap["decision"].(string),
+			ResourceARN: tfMap["resource_arn"].(string),
+		})
+	}
+
+	return expectations
+}
+
+// expandSimulateCustomPolicyInput returns the simulation input shared by custom and principal policy simulations.
+func expandSimulateCustomPolicyInput(d *schema.ResourceData, meta interface{}) *iam.SimulateCustomPolicyInput {
+	input := &iam.SimulateCustomPolicyInput{
+		ActionNames: flex.ExpandStringSet(d.Get("action_names").(*schema.Set)),
+	}
+
+	if v, ok := d.GetOk("caller_arn"); ok {
+		input.CallerArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("context"); ok && v.(*schema.Set).Len() > 0 {
+		input.ContextEntries = expandContextEntries(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("permissions_boundary_policies_json"); ok && len(v.([]interface{})) > 0 {
+		input.PermissionsBoundaryPolicyInputList = flex.ExpandStringList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("policies_json"); ok && len(v.([]interface{})) > 0 {
+		input.PolicyInputList = flex.ExpandStringList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("resource_arns"); ok && v.(*schema.Set).Len() > 0 {
+		input.ResourceArns = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("resource_handling_option"); ok {
+		input.ResourceHandlingOption = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("resource_owner_account_id"); ok {
+		input.ResourceOwner = aws.String(resourceOwnerARN(meta, v.(string)))
+	}
+
+	if v, ok := d.GetOk("resource_policy_json"); ok {
+		input.ResourcePolicy = aws.String(v.(string))
+	}
+
+	return input
+}
+
+func resourceOwnerARN(meta interface{}, accountID string) string {
+	return fmt.Sprintf("arn:%s:iam::%s:root", meta.(*conns.AWSClient).Partition, accountID)
+}
+
+func expandContextEntries(tfList []interface{}) []*iam.ContextEntry {
+	var apiObjects []*iam.ContextEntry
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &iam.ContextEntry{
+			ContextKeyName:   aws.String(tfMap["key"].(string)),
+			ContextKeyType:   aws.String(tfMap["type"].(string)),
+			ContextKeyValues: flex.ExpandStringSet(tfMap["values"].(*schema.Set)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenEvaluationResults(apiObjects []*iam.EvaluationResult) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"action_name":          aws.StringValue(apiObject.EvalActionName),
+			"allowed":              aws.StringValue(apiObject.EvalDecision) == iam.PolicyEvaluationDecisionTypeAllowed,
+			"decision":             aws.StringValue(apiObject.EvalDecision),
+			"decision_details":     aws.StringValueMap(apiObject.EvalDecisionDetails),
+			"matched_statements":   flattenStatements(apiObject.MatchedStatements),
+			"missing_context_keys": aws.StringValueSlice(apiObject.MissingContextValues),
+			"resource_arn":         aws.StringValue(apiObject.EvalResourceName),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenStatements(apiObjects []*iam.Statement) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"source_policy_id":   aws.StringValue(apiObject.SourcePolicyId),
+			"source_policy_type": aws.StringValue(apiObject.SourcePolicyType),
+		})
+	}
+
+	return tfList
+}
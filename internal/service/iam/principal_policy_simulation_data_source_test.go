@@ -0,0 +1,191 @@
+package iam_test
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/nij4t/terraform-provider-aws/internal/acctest"
+	tfiam "github.com/nij4t/terraform-provider-aws/internal/service/iam"
+)
+
+func TestUnexpectedPolicySimulationDecisions(t *testing.T) {
+	results := []*iam.EvaluationResult{
+		{
+			EvalActionName:   aws.String("s3:GetObject"),
+			EvalDecision:     aws.String(iam.PolicyEvaluationDecisionTypeAllowed),
+			EvalResourceName: aws.String("*"),
+		},
+		nil,
+		{
+			EvalActionName:   aws.String("s3:PutObject"),
+			EvalDecision:     aws.String(iam.PolicyEvaluationDecisionTypeImplicitDeny),
+			EvalResourceName: aws.String("arn:aws:s3:::example/*"), //lintignore:AWSAT005
+		},
+	}
+
+	testCases := []struct {
+		Name         string
+		Expectations []tfiam.PolicySimulationExpectation
+		Want         []string
+	}{
+		{
+			Name: "mixed decisions",
+			Expectations: []tfiam.PolicySimulationExpectation{
+				{ActionName: "s3:GetObject", Decision: iam.PolicyEvaluationDecisionTypeAllowed},
+				{ActionName: "s3:PutObject", Decision: iam.PolicyEvaluationDecisionTypeImplicitDeny, ResourceARN: "arn:aws:s3:::example/*"}, //lintignore:AWSAT005
+			},
+		},
+		{
+			Name: "denied matches implicit deny",
+			Expectations: []tfiam.PolicySimulationExpectation{
+				{ActionName: "S3:PutObject", Decision: tfiam.PolicySimulationDecisionDenied},
+			},
+		},
+		{
+			Name: "unexpected decisions",
+			Expectations: []tfiam.PolicySimulationExpectation{
+				{ActionName: "s3:GetObject", Decision: tfiam.PolicySimulationDecisionDenied},
+				{ActionName: "s3:PutObject", Decision: iam.PolicyEvaluationDecisionTypeExplicitDeny},
+			},
+			Want: []string{
+				"  s3:GetObject on *: allowed, expected denied",
+				"  s3:PutObject on arn:aws:s3:::example/*: implicitDeny, expected explicitDeny", //lintignore:AWSAT005
+			},
+		},
+		{
+			Name: "not simulated",
+			Expectations: []tfiam.PolicySimulationExpectation{
+				{ActionName: "s3:DeleteObject", Decision: iam.PolicyEvaluationDecisionTypeAllowed},
+				{ActionName: "s3:GetObject", Decision: iam.PolicyEvaluationDecisionTypeAllowed, ResourceARN: "arn:aws:s3:::other"}, //lintignore:AWSAT005
+			},
+			Want: []string{
+				"  s3:DeleteObject on any resource: not simulated, expected allowed",
+				"  s3:GetObject on arn:aws:s3:::other: not simulated, expected allowed", //lintignore:AWSAT005
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := tfiam.UnexpectedPolicySimulationDecisions(results, testCase.Expectations)
+
+			if !reflect.DeepEqual(got, testCase.Want) {
+				t.Errorf("got %v, expected %v", got, testCase.Want)
+			}
+		})
+	}
+}
+
+func TestAccIAMPrincipalPolicySimulationDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_iam_principal_policy_simulation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrincipalPolicySimulationDataSourceConfig(rName, "s3:GetObject", "allowed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "all_allowed", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.action_name", "s3:GetObject"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.allowed", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.decision", "allowed"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.matched_statements.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPrincipalPolicySimulationDataSource_expectedDecision(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPrincipalPolicySimulationDataSourceConfig(rName, "s3:PutObject", "allowed"),
+				ExpectError: regexp.MustCompile(`unexpected decisions`),
+			},
+		},
+	})
+}
+
+func TestAccIAMPrincipalPolicySimulationDataSource_customPolicy(t *testing.T) {
+	dataSourceName := "data.aws_iam_principal_policy_simulation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrincipalPolicySimulationDataSourceConfig_customPolicy(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "all_allowed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPrincipalPolicySimulationDataSourceConfig(rName, action, expectedDecision string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_user" "test" {
+  name = %[1]q
+}
+
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions   = ["s3:GetObject"]
+    resources = ["*"]
+  }
+}
+
+resource "aws_iam_user_policy" "test" {
+  name   = %[1]q
+  user   = aws_iam_user.test.name
+  policy = data.aws_iam_policy_document.test.json
+}
+
+data "aws_iam_principal_policy_simulation" "test" {
+  action_names      = [%[2]q]
+  policy_source_arn = aws_iam_user.test.arn
+
+  expected_decisions {
+    action_name = %[2]q
+    decision    = %[3]q
+  }
+
+  depends_on = [aws_iam_user_policy.test]
+}
+`, rName, action, expectedDecision)
+}
+
+func testAccPrincipalPolicySimulationDataSourceConfig_customPolicy() string {
+	return `
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions   = ["ec2:DescribeInstances"]
+    resources = ["*"]
+  }
+}
+
+data "aws_iam_principal_policy_simulation" "test" {
+  action_names  = ["ec2:DescribeInstances", "ec2:TerminateInstances"]
+  policies_json = [data.aws_iam_policy_document.test.json]
+}
+`
+}
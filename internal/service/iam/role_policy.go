@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -56,6 +57,8 @@ func ResourceRolePolicy() *schema.Resource {
 				ForceNew: true,
 			},
 		},
+
+		CustomizeDiff: PolicyValidationCustomizeDiff("policy", accessanalyzer.PolicyTypeIdentityPolicy),
 	}
 }
 
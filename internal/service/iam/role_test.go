@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/nij4t/terraform-provider-aws/internal/acctest"
+	"github.com/nij4t/terraform-provider-aws/internal/acctest/fakeaws"
 	"github.com/nij4t/terraform-provider-aws/internal/conns"
 	"github.com/nij4t/terraform-provider-aws/internal/create"
 	tfiam "github.com/nij4t/terraform-provider-aws/internal/service/iam"
@@ -2079,3 +2080,46 @@ EOF
 }
 `, roleName, policyName)
 }
+
+func TestIAMRole_fakeAWS(t *testing.T) {
+	p, _ := acctest.FakeAWSProvider(t)
+	r := acctest.NewFakeAWSResource(t, p, "aws_iam_role")
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	assumeRolePolicy := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}]}`
+
+	state := r.Apply(map[string]interface{}{
+		"name":               rName,
+		"assume_role_policy": assumeRolePolicy,
+	})
+
+	if got, want := state.Attributes["arn"], fmt.Sprintf("arn:%s:iam::%s:role/%s", acctest.Partition(), fakeaws.AccountID, rName); got != want {
+		t.Errorf("arn = %q, want %q", got, want)
+	}
+
+	state = r.Apply(map[string]interface{}{
+		"name":                 rName,
+		"assume_role_policy":   assumeRolePolicy,
+		"description":          "Test",
+		"max_session_duration": 7200,
+		"inline_policy": []interface{}{
+			map[string]interface{}{
+				"name":   rName,
+				"policy": `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"ec2:Describe*","Resource":"*"}]}`,
+			},
+		},
+		"tags": map[string]interface{}{
+			"key1": "value1",
+		},
+	})
+
+	if got, want := state.Attributes["description"], "Test"; got != want {
+		t.Errorf("description = %q, want %q", got, want)
+	}
+
+	if got, want := state.Attributes["inline_policy.#"], "1"; got != want {
+		t.Errorf("inline_policy.# = %q, want %q", got, want)
+	}
+
+	r.ImportStateVerify()
+	r.Destroy()
+}
@@ -69,7 +69,7 @@ func resourceAliasCreate(d *schema.ResourceData, meta interface{}) error {
 	if namePrefix == "" {
 		namePrefix = AliasNamePrefix
 	}
-	name := create.Name(d.Get("name").(string), namePrefix)
+	name := create.Name(meta.(*conns.AWSClient).NamingPolicy, d.Get("name").(string), namePrefix)
 
 	input := &kms.CreateAliasInput{
 		AliasName:   aws.String(name),
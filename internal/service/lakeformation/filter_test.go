@@ -534,6 +534,144 @@ func TestFilterPermissions(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "lfTag",
+			Input: &lakeformation.ListPermissionsInput{
+				Principal: principal,
+				Resource: &lakeformation.Resource{
+					LFTag: &lakeformation.LFTagKeyResource{
+						CatalogId: aws.String(accountID),
+						TagKey:    aws.String("domain"),
+						TagValues: aws.StringSlice([]string{"sales", "marketing"}),
+					},
+				},
+			},
+			All: []*lakeformation.PrincipalResourcePermissions{
+				{
+					Permissions:                aws.StringSlice([]string{lakeformation.PermissionDescribe}),
+					PermissionsWithGrantOption: aws.StringSlice([]string{}),
+					Principal:                  principal,
+					Resource: &lakeformation.Resource{
+						LFTag: &lakeformation.LFTagKeyResource{
+							CatalogId: aws.String(accountID),
+							TagKey:    aws.String("domain"),
+							TagValues: aws.StringSlice([]string{"marketing", "sales"}),
+						},
+					},
+				},
+				{
+					Permissions:                aws.StringSlice([]string{lakeformation.PermissionDescribe}),
+					PermissionsWithGrantOption: aws.StringSlice([]string{}),
+					Principal:                  principal,
+					Resource: &lakeformation.Resource{
+						LFTag: &lakeformation.LFTagKeyResource{
+							CatalogId: aws.String(accountID),
+							TagKey:    aws.String("domain"),
+							TagValues: aws.StringSlice([]string{"finance"}),
+						},
+					},
+				},
+			},
+			ExpectedClean: []*lakeformation.PrincipalResourcePermissions{
+				{
+					Permissions:                aws.StringSlice([]string{lakeformation.PermissionDescribe}),
+					PermissionsWithGrantOption: aws.StringSlice([]string{}),
+					Principal:                  principal,
+					Resource: &lakeformation.Resource{
+						LFTag: &lakeformation.LFTagKeyResource{
+							CatalogId: aws.String(accountID),
+							TagKey:    aws.String("domain"),
+							TagValues: aws.StringSlice([]string{"marketing", "sales"}),
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "lfTagPolicy",
+			Input: &lakeformation.ListPermissionsInput{
+				Principal: principal,
+				Resource: &lakeformation.Resource{
+					LFTagPolicy: &lakeformation.LFTagPolicyResource{
+						CatalogId: aws.String(accountID),
+						Expression: []*lakeformation.LFTag{
+							{
+								TagKey:    aws.String("domain"),
+								TagValues: aws.StringSlice([]string{"sales"}),
+							},
+							{
+								TagKey:    aws.String("sensitivity"),
+								TagValues: aws.StringSlice([]string{"public", "internal"}),
+							},
+						},
+						ResourceType: aws.String(lakeformation.ResourceTypeTable),
+					},
+				},
+			},
+			All: []*lakeformation.PrincipalResourcePermissions{
+				{
+					Permissions:                aws.StringSlice([]string{lakeformation.PermissionSelect}),
+					PermissionsWithGrantOption: aws.StringSlice([]string{}),
+					Principal:                  principal,
+					Resource: &lakeformation.Resource{
+						LFTagPolicy: &lakeformation.LFTagPolicyResource{
+							CatalogId: aws.String(accountID),
+							Expression: []*lakeformation.LFTag{
+								{
+									TagKey:    aws.String("sensitivity"),
+									TagValues: aws.StringSlice([]string{"internal", "public"}),
+								},
+								{
+									TagKey:    aws.String("domain"),
+									TagValues: aws.StringSlice([]string{"sales"}),
+								},
+							},
+							ResourceType: aws.String(lakeformation.ResourceTypeTable),
+						},
+					},
+				},
+				{
+					Permissions:                aws.StringSlice([]string{lakeformation.PermissionDescribe}),
+					PermissionsWithGrantOption: aws.StringSlice([]string{}),
+					Principal:                  principal,
+					Resource: &lakeformation.Resource{
+						LFTagPolicy: &lakeformation.LFTagPolicyResource{
+							CatalogId: aws.String(accountID),
+							Expression: []*lakeformation.LFTag{
+								{
+									TagKey:    aws.String("domain"),
+									TagValues: aws.StringSlice([]string{"sales"}),
+								},
+							},
+							ResourceType: aws.String(lakeformation.ResourceTypeDatabase),
+						},
+					},
+				},
+			},
+			ExpectedClean: []*lakeformation.PrincipalResourcePermissions{
+				{
+					Permissions:                aws.StringSlice([]string{lakeformation.PermissionSelect}),
+					PermissionsWithGrantOption: aws.StringSlice([]string{}),
+					Principal:                  principal,
+					Resource: &lakeformation.Resource{
+						LFTagPolicy: &lakeformation.LFTagPolicyResource{
+							CatalogId: aws.String(accountID),
+							Expression: []*lakeformation.LFTag{
+								{
+									TagKey:    aws.String("sensitivity"),
+									TagValues: aws.StringSlice([]string{"internal", "public"}),
+								},
+								{
+									TagKey:    aws.String("domain"),
+									TagValues: aws.StringSlice([]string{"sales"}),
+								},
+							},
+							ResourceType: aws.String(lakeformation.ResourceTypeTable),
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -12,6 +12,11 @@ func TestAccLakeFormation_serial(t *testing.T) {
 			"disappears":       testAccDataLakeSettings_disappears,
 			"withoutCatalogId": testAccDataLakeSettings_withoutCatalogID,
 		},
+		"LFTag": {
+			"basic":      testAccLFTag_basic,
+			"disappears": testAccLFTag_disappears,
+			"values":     testAccLFTag_values,
+		},
 		"PermissionsBasic": {
 			"basic":              testAccPermissions_basic,
 			"database":           testAccPermissions_database,
@@ -19,6 +24,9 @@ func TestAccLakeFormation_serial(t *testing.T) {
 			"databaseMultiple":   testAccPermissions_databaseMultiple,
 			"dataLocation":       testAccPermissions_dataLocation,
 			"disappears":         testAccPermissions_disappears,
+			"lfTag":              testAccPermissions_lfTag,
+			"lfTagPolicy":        testAccPermissions_lfTagPolicy,
+			"updatePermissions":  testAccPermissions_updatePermissions,
 		},
 		"PermissionsDataSource": {
 			"basic":            testAccPermissionsDataSource_basic,
@@ -45,6 +53,11 @@ func TestAccLakeFormation_serial(t *testing.T) {
 			"wildcardSelectOnly":      testAccPermissions_twcWildcardSelectOnly,
 			"wildcardSelectPlus":      testAccPermissions_twcWildcardSelectPlus,
 		},
+		"ResourceLFTags": {
+			"database":         testAccResourceLFTags_database,
+			"table":            testAccResourceLFTags_table,
+			"tableWithColumns": testAccResourceLFTags_tableWithColumns,
+		},
 	}
 
 	for group, m := range testCases {
@@ -0,0 +1,203 @@
+package lakeformation
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nij4t/terraform-provider-aws/internal/conns"
+	"github.com/nij4t/terraform-provider-aws/internal/flex"
+	"github.com/nij4t/terraform-provider-aws/internal/verify"
+)
+
+func ResourceLFTag() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLFTagCreate,
+		Read:   resourceLFTagRead,
+		Update: resourceLFTagUpdate,
+		Delete: resourceLFTagDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"catalog_id": {
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"values": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 15,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validLFTagValue,
+				},
+				Set: schema.HashString,
+			},
+		},
+	}
+}
+
+func resourceLFTagCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LakeFormationConn
+
+	tagKey := d.Get("key").(string)
+
+	var catalogID string
+	if v, ok := d.GetOk("catalog_id"); ok {
+		catalogID = v.(string)
+	} else {
+		catalogID = meta.(*conns.AWSClient).AccountID
+	}
+
+	input := &lakeformation.CreateLFTagInput{
+		CatalogId: aws.String(catalogID),
+		TagKey:    aws.String(tagKey),
+		TagValues: flex.ExpandStringSet(d.Get("values").(*schema.Set)),
+	}
+
+	log.Printf("[DEBUG] Creating Lake Formation LF-Tag: %s", input)
+	_, err := conn.CreateLFTag(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Lake Formation LF-Tag (%s): %w", tagKey, err)
+	}
+
+	d.SetId(LFTagCreateResourceID(catalogID, tagKey))
+
+	return resourceLFTagRead(d, meta)
+}
+
+func resourceLFTagRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LakeFormationConn
+
+	catalogID, tagKey, err := LFTagParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := conn.GetLFTag(&lakeformation.GetLFTagInput{
+		CatalogId: aws.String(catalogID),
+		TagKey:    aws.String(tagKey),
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) {
+		log.Printf("[WARN] Lake Formation LF-Tag (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lake Formation LF-Tag (%s): %w", d.Id(), err)
+	}
+
+	if output == nil {
+		return fmt.Errorf("error reading Lake Formation LF-Tag (%s): empty response", d.Id())
+	}
+
+	d.Set("catalog_id", output.CatalogId)
+	d.Set("key", output.TagKey)
+
+	if err := d.Set("values", flex.FlattenStringSet(output.TagValues)); err != nil {
+		return fmt.Errorf("error setting values: %w", err)
+	}
+
+	return nil
+}
+
+func resourceLFTagUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LakeFormationConn
+
+	catalogID, tagKey, err := LFTagParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	o, n := d.GetChange("values")
+	os, ns := o.(*schema.Set), n.(*schema.Set)
+
+	input := &lakeformation.UpdateLFTagInput{
+		CatalogId: aws.String(catalogID),
+		TagKey:    aws.String(tagKey),
+	}
+
+	if add := ns.Difference(os); add.Len() > 0 {
+		input.TagValuesToAdd = flex.ExpandStringSet(add)
+	}
+
+	if del := os.Difference(ns); del.Len() > 0 {
+		input.TagValuesToDelete = flex.ExpandStringSet(del)
+	}
+
+	log.Printf("[DEBUG] Updating Lake Formation LF-Tag: %s", input)
+	_, err = conn.UpdateLFTag(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Lake Formation LF-Tag (%s): %w", d.Id(), err)
+	}
+
+	return resourceLFTagRead(d, meta)
+}
+
+func resourceLFTagDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LakeFormationConn
+
+	catalogID, tagKey, err := LFTagParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Lake Formation LF-Tag: %s", d.Id())
+	_, err = conn.DeleteLFTag(&lakeformation.DeleteLFTagInput{
+		CatalogId: aws.String(catalogID),
+		TagKey:    aws.String(tagKey),
+	})
+
+	if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Lake Formation LF-Tag (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+const lfTagResourceIDSeparator = ":"
+
+func LFTagCreateResourceID(catalogID, tagKey string) string {
+	parts := []string{catalogID, tagKey}
+	id := strings.Join(parts, lfTagResourceIDSeparator)
+
+	return id
+}
+
+func LFTagParseResourceID(id string) (string, string, error) {
+	parts := strings.SplitN(id, lfTagResourceIDSeparator, 2)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected CATALOG-ID%[2]sTAG-KEY", id, lfTagResourceIDSeparator)
+}
@@ -23,6 +23,7 @@ func ResourcePermissions() *schema.Resource {
 	return &schema.Resource{
 		Create: resourcePermissionsCreate,
 		Read:   resourcePermissionsRead,
+		Update: resourcePermissionsUpdate,
 		Delete: resourcePermissionsDelete,
 
 		Schema: map[string]*schema.Schema{
@@ -41,6 +42,8 @@ func ResourcePermissions() *schema.Resource {
 					"catalog_resource",
 					"data_location",
 					"database",
+					"lf_tag",
+					"lf_tag_policy",
 					"table",
 					"table_with_columns",
 				},
@@ -55,6 +58,8 @@ func ResourcePermissions() *schema.Resource {
 					"catalog_resource",
 					"data_location",
 					"database",
+					"lf_tag",
+					"lf_tag_policy",
 					"table",
 					"table_with_columns",
 				},
@@ -86,6 +91,8 @@ func ResourcePermissions() *schema.Resource {
 					"catalog_resource",
 					"data_location",
 					"database",
+					"lf_tag",
+					"lf_tag_policy",
 					"table",
 					"table_with_columns",
 				},
@@ -106,9 +113,115 @@ func ResourcePermissions() *schema.Resource {
 					},
 				},
 			},
-			"permissions": {
+			"lf_tag": {
 				Type:     schema.TypeList,
+				Computed: true,
 				ForceNew: true,
+				MaxItems: 1,
+				Optional: true,
+				ExactlyOneOf: []string{
+					"catalog_resource",
+					"data_location",
+					"database",
+					"lf_tag",
+					"lf_tag_policy",
+					"table",
+					"table_with_columns",
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_id": {
+							Type:         schema.TypeString,
+							Computed:     true,
+							ForceNew:     true,
+							Optional:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+						"key": {
+							Type:         schema.TypeString,
+							ForceNew:     true,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+						"values": {
+							Type:     schema.TypeSet,
+							ForceNew: true,
+							MaxItems: 15,
+							MinItems: 1,
+							Required: true,
+							Set:      schema.HashString,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validLFTagValue,
+							},
+						},
+					},
+				},
+			},
+			"lf_tag_policy": {
+				Type:     schema.TypeList,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Optional: true,
+				ExactlyOneOf: []string{
+					"catalog_resource",
+					"data_location",
+					"database",
+					"lf_tag",
+					"lf_tag_policy",
+					"table",
+					"table_with_columns",
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_id": {
+							Type:         schema.TypeString,
+							Computed:     true,
+							ForceNew:     true,
+							Optional:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+						"expression": {
+							Type:     schema.TypeList,
+							ForceNew: true,
+							MaxItems: 5,
+							MinItems: 1,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:         schema.TypeString,
+										ForceNew:     true,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 128),
+									},
+									"values": {
+										Type:     schema.TypeSet,
+										ForceNew: true,
+										MaxItems: 15,
+										MinItems: 1,
+										Required: true,
+										Set:      schema.HashString,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validLFTagValue,
+										},
+									},
+								},
+							},
+						},
+						"resource_type": {
+							Type:         schema.TypeString,
+							ForceNew:     true,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(lakeformation.ResourceType_Values(), false),
+						},
+					},
+				},
+			},
+			"permissions": {
+				Type:     schema.TypeList,
 				MinItems: 1,
 				Required: true,
 				Elem: &schema.Schema{
@@ -119,7 +232,6 @@ func ResourcePermissions() *schema.Resource {
 			"permissions_with_grant_option": {
 				Type:     schema.TypeList,
 				Computed: true,
-				ForceNew: true,
 				Optional: true,
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
@@ -142,6 +254,8 @@ func ResourcePermissions() *schema.Resource {
 					"catalog_resource",
 					"data_location",
 					"database",
+					"lf_tag",
+					"lf_tag_policy",
 					"table",
 					"table_with_columns",
 				},
@@ -192,6 +306,8 @@ func ResourcePermissions() *schema.Resource {
 					"catalog_resource",
 					"data_location",
 					"database",
+					"lf_tag",
+					"lf_tag_policy",
 					"table",
 					"table_with_columns",
 				},
@@ -274,7 +390,7 @@ func resourcePermissionsCreate(d *schema.ResourceData, meta interface{}) error {
 		Principal: &lakeformation.DataLakePrincipal{
 			DataLakePrincipalIdentifier: aws.String(d.Get("principal").(string)),
 		},
-		Resource: &lakeformation.Resource{},
+		Resource: expandPermissionsResource(d),
 	}
 
 	if v, ok := d.GetOk("catalog_id"); ok {
@@ -285,26 +401,6 @@ func resourcePermissionsCreate(d *schema.ResourceData, meta interface{}) error {
 		input.PermissionsWithGrantOption = flex.ExpandStringList(v.([]interface{}))
 	}
 
-	if _, ok := d.GetOk("catalog_resource"); ok {
-		input.Resource.Catalog = ExpandCatalogResource()
-	}
-
-	if v, ok := d.GetOk("data_location"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		input.Resource.DataLocation = ExpandDataLocationResource(v.([]interface{})[0].(map[string]interface{}))
-	}
-
-	if v, ok := d.GetOk("database"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		input.Resource.Database = ExpandDatabaseResource(v.([]interface{})[0].(map[string]interface{}))
-	}
-
-	if v, ok := d.GetOk("table"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		input.Resource.Table = ExpandTableResource(v.([]interface{})[0].(map[string]interface{}))
-	}
-
-	if v, ok := d.GetOk("table_with_columns"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		input.Resource.TableWithColumns = expandLakeFormationTableWithColumnsResource(v.([]interface{})[0].(map[string]interface{}))
-	}
-
 	var output *lakeformation.GrantPermissionsOutput
 	err := resource.Retry(tfiam.PropagationTimeout, func() *resource.RetryError {
 		var err error
@@ -374,6 +470,14 @@ func resourcePermissionsRead(d *schema.ResourceData, meta interface{}) error {
 		input.Resource.Database = ExpandDatabaseResource(v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("lf_tag"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Resource.LFTag = ExpandLFTagKeyResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("lf_tag_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Resource.LFTagPolicy = ExpandLFTagPolicyResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	tableType := ""
 
 	if v, ok := d.GetOk("table"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
@@ -445,6 +549,8 @@ func resourcePermissionsRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("catalog_resource", false)
 		d.Set("data_location", nil)
 		d.Set("database", nil)
+		d.Set("lf_tag", nil)
+		d.Set("lf_tag_policy", nil)
 		d.Set("table_with_columns", nil)
 		d.Set("table", nil)
 		return nil
@@ -480,6 +586,22 @@ func resourcePermissionsRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("database", nil)
 	}
 
+	if cleanPermissions[0].Resource.LFTag != nil {
+		if err := d.Set("lf_tag", []interface{}{flattenLFTagKeyResource(cleanPermissions[0].Resource.LFTag)}); err != nil {
+			return fmt.Errorf("error setting lf_tag: %w", err)
+		}
+	} else {
+		d.Set("lf_tag", nil)
+	}
+
+	if cleanPermissions[0].Resource.LFTagPolicy != nil {
+		if err := d.Set("lf_tag_policy", []interface{}{flattenLFTagPolicyResource(cleanPermissions[0].Resource.LFTagPolicy)}); err != nil {
+			return fmt.Errorf("error setting lf_tag_policy: %w", err)
+		}
+	} else {
+		d.Set("lf_tag_policy", nil)
+	}
+
 	tableSet := false
 
 	if v, ok := d.GetOk("table"); ok && len(v.([]interface{})) > 0 {
@@ -533,6 +655,79 @@ func resourcePermissionsRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+// Changes to permissions are applied as a grant of the added permissions and a
+// revoke of the removed ones, so that permissions that remain in configuration are
+// never revoked, even momentarily.
+func resourcePermissionsUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LakeFormationConn
+
+	o, n := d.GetChange("permissions")
+	oldPermissions := flex.ExpandStringList(o.([]interface{}))
+	newPermissions := flex.ExpandStringList(n.([]interface{}))
+
+	o, n = d.GetChange("permissions_with_grant_option")
+	oldGrantPermissions := flex.ExpandStringList(o.([]interface{}))
+	newGrantPermissions := flex.ExpandStringList(n.([]interface{}))
+
+	revokePermissions := StringSlicesDifference(oldPermissions, newPermissions)
+	revokeGrantPermissions := StringSlicesDifference(oldGrantPermissions, newGrantPermissions)
+
+	if len(revokePermissions) > 0 || len(revokeGrantPermissions) > 0 {
+		input := &lakeformation.RevokePermissionsInput{
+			Permissions:                revokePermissions,
+			PermissionsWithGrantOption: revokeGrantPermissions,
+			Principal: &lakeformation.DataLakePrincipal{
+				DataLakePrincipalIdentifier: aws.String(d.Get("principal").(string)),
+			},
+			Resource: expandPermissionsResource(d),
+		}
+
+		if v, ok := d.GetOk("catalog_id"); ok {
+			input.CatalogId = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Revoking Lake Formation Permissions: %s", input)
+		_, err := tfresource.RetryWhenAWSErrCodeEquals(permissionsDeleteRetryTimeout, func() (interface{}, error) {
+			return conn.RevokePermissions(input)
+		}, lakeformation.ErrCodeConcurrentModificationException)
+
+		if err != nil && !tfawserr.ErrMessageContains(err, lakeformation.ErrCodeInvalidInputException, "No permissions revoked. Grantee") {
+			return fmt.Errorf("error updating Lake Formation Permissions (%s): revoking: %w", d.Id(), err)
+		}
+	}
+
+	grantGrantPermissions := StringSlicesDifference(newGrantPermissions, oldGrantPermissions)
+	// A permission must be granted for its grant option to be granted.
+	grantPermissions := StringSlicesDifference(newPermissions, oldPermissions)
+	grantPermissions = append(grantPermissions, StringSlicesDifference(grantGrantPermissions, grantPermissions)...)
+
+	if len(grantPermissions) > 0 {
+		input := &lakeformation.GrantPermissionsInput{
+			Permissions:                grantPermissions,
+			PermissionsWithGrantOption: grantGrantPermissions,
+			Principal: &lakeformation.DataLakePrincipal{
+				DataLakePrincipalIdentifier: aws.String(d.Get("principal").(string)),
+			},
+			Resource: expandPermissionsResource(d),
+		}
+
+		if v, ok := d.GetOk("catalog_id"); ok {
+			input.CatalogId = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Granting Lake Formation Permissions: %s", input)
+		_, err := tfresource.RetryWhenAWSErrCodeEquals(tfiam.PropagationTimeout, func() (interface{}, error) {
+			return conn.GrantPermissions(input)
+		}, lakeformation.ErrCodeConcurrentModificationException)
+
+		if err != nil {
+			return fmt.Errorf("error updating Lake Formation Permissions (%s): granting: %w", d.Id(), err)
+		}
+	}
+
+	return resourcePermissionsRead(d, meta)
+}
+
 func resourcePermissionsDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).LakeFormationConn
 
@@ -542,33 +737,13 @@ func resourcePermissionsDelete(d *schema.ResourceData, meta interface{}) error {
 		Principal: &lakeformation.DataLakePrincipal{
 			DataLakePrincipalIdentifier: aws.String(d.Get("principal").(string)),
 		},
-		Resource: &lakeformation.Resource{},
+		Resource: expandPermissionsResource(d),
 	}
 
 	if v, ok := d.GetOk("catalog_id"); ok {
 		input.CatalogId = aws.String(v.(string))
 	}
 
-	if _, ok := d.GetOk("catalog_resource"); ok {
-		input.Resource.Catalog = ExpandCatalogResource()
-	}
-
-	if v, ok := d.GetOk("data_location"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		input.Resource.DataLocation = ExpandDataLocationResource(v.([]interface{})[0].(map[string]interface{}))
-	}
-
-	if v, ok := d.GetOk("database"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		input.Resource.Database = ExpandDatabaseResource(v.([]interface{})[0].(map[string]interface{}))
-	}
-
-	if v, ok := d.GetOk("table"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		input.Resource.Table = ExpandTableResource(v.([]interface{})[0].(map[string]interface{}))
-	}
-
-	if v, ok := d.GetOk("table_with_columns"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		input.Resource.TableWithColumns = expandLakeFormationTableWithColumnsResource(v.([]interface{})[0].(map[string]interface{}))
-	}
-
 	if input.Resource == nil || reflect.DeepEqual(input.Resource, &lakeformation.Resource{}) {
 		// if resource is empty, don't delete = it won't delete anything since this is the predicate
 		log.Printf("[WARN] No Lake Formation Resource with permissions to revoke")
@@ -644,6 +819,40 @@ func resourcePermissionsDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func expandPermissionsResource(d *schema.ResourceData) *lakeformation.Resource {
+	apiObject := &lakeformation.Resource{}
+
+	if _, ok := d.GetOk("catalog_resource"); ok {
+		apiObject.Catalog = ExpandCatalogResource()
+	}
+
+	if v, ok := d.GetOk("data_location"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject.DataLocation = ExpandDataLocationResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("database"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject.Database = ExpandDatabaseResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("lf_tag"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject.LFTag = ExpandLFTagKeyResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("lf_tag_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject.LFTagPolicy = ExpandLFTagPolicyResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("table"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject.Table = ExpandTableResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("table_with_columns"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject.TableWithColumns = expandLakeFormationTableWithColumnsResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
 func ExpandCatalogResource() *lakeformation.CatalogResource {
 	return &lakeformation.CatalogResource{}
 }
@@ -720,6 +929,159 @@ func flattenLakeFormationDatabaseResource(apiObject *lakeformation.DatabaseResou
 	return tfMap
 }
 
+func ExpandLFTagKeyResource(tfMap map[string]interface{}) *lakeformation.LFTagKeyResource {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &lakeformation.LFTagKeyResource{}
+
+	if v, ok := tfMap["catalog_id"].(string); ok && v != "" {
+		apiObject.CatalogId = aws.String(v)
+	}
+
+	if v, ok := tfMap["key"].(string); ok && v != "" {
+		apiObject.TagKey = aws.String(v)
+	}
+
+	if v, ok := tfMap["values"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.TagValues = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func flattenLFTagKeyResource(apiObject *lakeformation.LFTagKeyResource) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CatalogId; v != nil {
+		tfMap["catalog_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.TagKey; v != nil {
+		tfMap["key"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.TagValues; v != nil {
+		tfMap["values"] = flex.FlattenStringSet(v)
+	}
+
+	return tfMap
+}
+
+func ExpandLFTagPolicyResource(tfMap map[string]interface{}) *lakeformation.LFTagPolicyResource {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &lakeformation.LFTagPolicyResource{}
+
+	if v, ok := tfMap["catalog_id"].(string); ok && v != "" {
+		apiObject.CatalogId = aws.String(v)
+	}
+
+	if v, ok := tfMap["expression"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Expression = expandLFTags(v)
+	}
+
+	if v, ok := tfMap["resource_type"].(string); ok && v != "" {
+		apiObject.ResourceType = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenLFTagPolicyResource(apiObject *lakeformation.LFTagPolicyResource) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CatalogId; v != nil {
+		tfMap["catalog_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Expression; v != nil {
+		tfMap["expression"] = flattenLFTags(v)
+	}
+
+	if v := apiObject.ResourceType; v != nil {
+		tfMap["resource_type"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func expandLFTag(tfMap map[string]interface{}) *lakeformation.LFTag {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &lakeformation.LFTag{}
+
+	if v, ok := tfMap["key"].(string); ok && v != "" {
+		apiObject.TagKey = aws.String(v)
+	}
+
+	if v, ok := tfMap["values"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.TagValues = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func expandLFTags(tfList []interface{}) []*lakeformation.LFTag {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*lakeformation.LFTag
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandLFTag(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenLFTags(apiObjects []*lakeformation.LFTag) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"key":    aws.StringValue(apiObject.TagKey),
+			"values": flex.FlattenStringSet(apiObject.TagValues),
+		})
+	}
+
+	return tfList
+}
+
 func ExpandTableResource(tfMap map[string]interface{}) *lakeformation.TableResource {
 	if tfMap == nil {
 		return nil
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -200,6 +201,97 @@ func testAccPermissions_dataLocation(t *testing.T) {
 	})
 }
 
+func testAccPermissions_lfTag(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_permissions.test"
+	roleName := "aws_iam_role.test"
+	tagName := "aws_lakeformation_lf_tag.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(lakeformation.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPermissionsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionsConfig_lfTag(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionsExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "principal", roleName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "catalog_resource", "false"),
+					resource.TestCheckResourceAttr(resourceName, "lf_tag.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "lf_tag.0.key", tagName, "key"),
+					resource.TestCheckResourceAttr(resourceName, "lf_tag.0.values.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "permissions_with_grant_option.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPermissions_lfTagPolicy(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_permissions.test"
+	roleName := "aws_iam_role.test"
+	tagName := "aws_lakeformation_lf_tag.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(lakeformation.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPermissionsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionsConfig_lfTagPolicy(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionsExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "principal", roleName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "catalog_resource", "false"),
+					resource.TestCheckResourceAttr(resourceName, "lf_tag_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "lf_tag_policy.0.resource_type", lakeformation.ResourceTypeDatabase),
+					resource.TestCheckResourceAttr(resourceName, "lf_tag_policy.0.expression.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "lf_tag_policy.0.expression.0.key", tagName, "key"),
+					resource.TestCheckResourceAttr(resourceName, "lf_tag_policy.0.expression.0.values.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPermissions_updatePermissions(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_permissions.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(lakeformation.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPermissionsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionsConfig_updatePermissions(rName, `"ALTER", "DROP"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permissions.*", lakeformation.PermissionAlter),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permissions.*", lakeformation.PermissionDrop),
+				),
+			},
+			{
+				Config: testAccPermissionsConfig_updatePermissions(rName, `"ALTER", "CREATE_TABLE"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permissions.*", lakeformation.PermissionAlter),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permissions.*", lakeformation.PermissionCreateTable),
+				),
+			},
+		},
+	})
+}
+
 func testAccPermissions_tableBasic(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_lakeformation_permissions.test"
@@ -736,6 +828,44 @@ func permissionCountForLakeFormationResource(conn *lakeformation.LakeFormation,
 		noResource = false
 	}
 
+	if v, ok := rs.Primary.Attributes["lf_tag.#"]; ok && v != "" && v != "0" {
+		input.Resource.LFTag = &lakeformation.LFTagKeyResource{
+			TagKey:    aws.String(rs.Primary.Attributes["lf_tag.0.key"]),
+			TagValues: aws.StringSlice(testAccPermissionsSetAttributeValues(rs.Primary.Attributes, "lf_tag.0.values")),
+		}
+
+		if v := rs.Primary.Attributes["lf_tag.0.catalog_id"]; v != "" {
+			input.Resource.LFTag.CatalogId = aws.String(v)
+		}
+
+		noResource = false
+	}
+
+	if v, ok := rs.Primary.Attributes["lf_tag_policy.#"]; ok && v != "" && v != "0" {
+		input.Resource.LFTagPolicy = &lakeformation.LFTagPolicyResource{
+			ResourceType: aws.String(rs.Primary.Attributes["lf_tag_policy.0.resource_type"]),
+		}
+
+		if v := rs.Primary.Attributes["lf_tag_policy.0.catalog_id"]; v != "" {
+			input.Resource.LFTagPolicy.CatalogId = aws.String(v)
+		}
+
+		exprCount, err := strconv.Atoi(rs.Primary.Attributes["lf_tag_policy.0.expression.#"])
+
+		if err != nil {
+			return 0, fmt.Errorf("acceptance test: could not convert string (%s) Atoi for expression: %w", rs.Primary.Attributes["lf_tag_policy.0.expression.#"], err)
+		}
+
+		for i := 0; i < exprCount; i++ {
+			input.Resource.LFTagPolicy.Expression = append(input.Resource.LFTagPolicy.Expression, &lakeformation.LFTag{
+				TagKey:    aws.String(rs.Primary.Attributes[fmt.Sprintf("lf_tag_policy.0.expression.%d.key", i)]),
+				TagValues: aws.StringSlice(testAccPermissionsSetAttributeValues(rs.Primary.Attributes, fmt.Sprintf("lf_tag_policy.0.expression.%d.values", i))),
+			})
+		}
+
+		noResource = false
+	}
+
 	tableType := ""
 
 	if v, ok := rs.Primary.Attributes["table.#"]; ok && v != "" && v != "0" {
@@ -885,6 +1015,22 @@ func permissionCountForLakeFormationResource(conn *lakeformation.LakeFormation,
 	return len(cleanPermissions), nil
 }
 
+// testAccPermissionsSetAttributeValues returns the elements of a set of strings
+// from flatmapped state attributes.
+func testAccPermissionsSetAttributeValues(attributes map[string]string, name string) []string {
+	var values []string
+
+	for k, v := range attributes {
+		if !strings.HasPrefix(k, name+".") || k == name+".#" {
+			continue
+		}
+
+		values = append(values, v)
+	}
+
+	return values
+}
+
 func testAccPermissionsConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 data "aws_partition" "current" {}
@@ -2095,3 +2241,128 @@ resource "aws_lakeformation_permissions" "test" {
 }
 `, rName)
 }
+
+func testAccPermissionsConfig_lfTagBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "glue.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+    Version = "2012-10-17"
+  })
+}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_iam_session_context" "current" {
+  arn = data.aws_caller_identity.current.arn
+}
+
+resource "aws_lakeformation_data_lake_settings" "test" {
+  admins = [data.aws_iam_session_context.current.issuer_arn]
+}
+
+resource "aws_lakeformation_lf_tag" "test" {
+  key    = %[1]q
+  values = ["value1", "value2", "value3"]
+
+  # for consistency, ensure that admins are setup before testing
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+`, rName)
+}
+
+func testAccPermissionsConfig_lfTag(rName string) string {
+	return acctest.ConfigCompose(testAccPermissionsConfig_lfTagBase(rName), `
+resource "aws_lakeformation_permissions" "test" {
+  principal                     = aws_iam_role.test.arn
+  permissions                   = ["ASSOCIATE", "DESCRIBE"]
+  permissions_with_grant_option = ["DESCRIBE"]
+
+  lf_tag {
+    key    = aws_lakeformation_lf_tag.test.key
+    values = ["value1", "value2"]
+  }
+
+  # for consistency, ensure that admins are setup before testing
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+`)
+}
+
+func testAccPermissionsConfig_lfTagPolicy(rName string) string {
+	return acctest.ConfigCompose(testAccPermissionsConfig_lfTagBase(rName), `
+resource "aws_lakeformation_permissions" "test" {
+  principal   = aws_iam_role.test.arn
+  permissions = ["ALTER", "CREATE_TABLE", "DROP"]
+
+  lf_tag_policy {
+    resource_type = "DATABASE"
+
+    expression {
+      key    = aws_lakeformation_lf_tag.test.key
+      values = ["value1", "value2"]
+    }
+  }
+
+  # for consistency, ensure that admins are setup before testing
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+`)
+}
+
+func testAccPermissionsConfig_updatePermissions(rName, permissions string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "glue.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+    Version = "2012-10-17"
+  })
+}
+
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_iam_session_context" "current" {
+  arn = data.aws_caller_identity.current.arn
+}
+
+resource "aws_lakeformation_data_lake_settings" "test" {
+  admins = [data.aws_iam_session_context.current.issuer_arn]
+}
+
+resource "aws_lakeformation_permissions" "test" {
+  principal   = aws_iam_role.test.arn
+  permissions = [%[2]s]
+
+  database {
+    name = aws_glue_catalog_database.test.name
+  }
+
+  # for consistency, ensure that admins are setup before testing
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+`, rName, permissions)
+}
@@ -0,0 +1,507 @@
+package lakeformation
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nij4t/terraform-provider-aws/internal/conns"
+	"github.com/nij4t/terraform-provider-aws/internal/create"
+	tfiam "github.com/nij4t/terraform-provider-aws/internal/service/iam"
+	"github.com/nij4t/terraform-provider-aws/internal/tfresource"
+	"github.com/nij4t/terraform-provider-aws/internal/verify"
+)
+
+func ResourceResourceLFTags() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceResourceLFTagsCreate,
+		Read:   resourceResourceLFTagsRead,
+		Update: resourceResourceLFTagsUpdate,
+		Delete: resourceResourceLFTagsDelete,
+
+		Schema: map[string]*schema.Schema{
+			"catalog_id": {
+				Type:         schema.TypeString,
+				Computed:     true,
+				ForceNew:     true,
+				Optional:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"database": {
+				Type:     schema.TypeList,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Optional: true,
+				ExactlyOneOf: []string{
+					"database",
+					"table",
+					"table_with_columns",
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_id": {
+							Type:         schema.TypeString,
+							Computed:     true,
+							ForceNew:     true,
+							Optional:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							ForceNew: true,
+							Required: true,
+						},
+					},
+				},
+			},
+			"lf_tag": {
+				Type:     schema.TypeSet,
+				MinItems: 1,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_id": {
+							Type:         schema.TypeString,
+							Computed:     true,
+							Optional:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+						"key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+						"value": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validLFTagValue,
+						},
+					},
+				},
+				Set: lfTagPairHash,
+			},
+			"table": {
+				Type:     schema.TypeList,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Optional: true,
+				ExactlyOneOf: []string{
+					"database",
+					"table",
+					"table_with_columns",
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_id": {
+							Type:         schema.TypeString,
+							Computed:     true,
+							ForceNew:     true,
+							Optional:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+						"database_name": {
+							Type:     schema.TypeString,
+							ForceNew: true,
+							Required: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+							ForceNew: true,
+							Optional: true,
+							AtLeastOneOf: []string{
+								"table.0.name",
+								"table.0.wildcard",
+							},
+						},
+						"wildcard": {
+							Type:     schema.TypeBool,
+							Default:  false,
+							ForceNew: true,
+							Optional: true,
+							AtLeastOneOf: []string{
+								"table.0.name",
+								"table.0.wildcard",
+							},
+						},
+					},
+				},
+			},
+			"table_with_columns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Optional: true,
+				ExactlyOneOf: []string{
+					"database",
+					"table",
+					"table_with_columns",
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_id": {
+							Type:         schema.TypeString,
+							Computed:     true,
+							ForceNew:     true,
+							Optional:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+						"column_names": {
+							Type:     schema.TypeSet,
+							ForceNew: true,
+							Required: true,
+							Set:      schema.HashString,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.NoZeroValues,
+							},
+						},
+						"database_name": {
+							Type:     schema.TypeString,
+							ForceNew: true,
+							Required: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							ForceNew: true,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceResourceLFTagsCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LakeFormationConn
+
+	input := &lakeformation.AddLFTagsToResourceInput{
+		LFTags:   expandLFTagPairs(d.Get("lf_tag").(*schema.Set).List()),
+		Resource: expandResourceLFTagsResource(d),
+	}
+
+	if v, ok := d.GetOk("catalog_id"); ok {
+		input.CatalogId = aws.String(v.(string))
+	}
+
+	if err := addLFTagsToResource(conn, input); err != nil {
+		return fmt.Errorf("error creating Lake Formation Resource LF-Tags: %w", err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", create.StringHashcode(input.String())))
+
+	return resourceResourceLFTagsRead(d, meta)
+}
+
+func resourceResourceLFTagsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LakeFormationConn
+
+	input := &lakeformation.GetResourceLFTagsInput{
+		Resource:           expandResourceLFTagsResource(d),
+		ShowAssignedLFTags: aws.Bool(true),
+	}
+
+	if v, ok := d.GetOk("catalog_id"); ok {
+		input.CatalogId = aws.String(v.(string))
+	}
+
+	output, err := conn.GetResourceLFTags(input)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) {
+		log.Printf("[WARN] Lake Formation Resource LF-Tags (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lake Formation Resource LF-Tags (%s): %w", d.Id(), err)
+	}
+
+	if output == nil {
+		return fmt.Errorf("error reading Lake Formation Resource LF-Tags (%s): empty response", d.Id())
+	}
+
+	var apiObjects []*lakeformation.LFTagPair
+
+	switch {
+	case input.Resource.Database != nil:
+		apiObjects = output.LFTagOnDatabase
+	case input.Resource.Table != nil:
+		apiObjects = output.LFTagsOnTable
+	case input.Resource.TableWithColumns != nil:
+		columnNames := d.Get("table_with_columns.0.column_names").(*schema.Set)
+
+		for _, column := range output.LFTagsOnColumns {
+			if column == nil || !columnNames.Contains(aws.StringValue(column.Name)) {
+				continue
+			}
+
+			apiObjects = append(apiObjects, column.LFTags...)
+		}
+	}
+
+	// Only LF-Tag keys managed by this resource are reported, so that other
+	// assignments on the same Data Catalog resource do not show up as drift.
+	managedKeys := make(map[string]struct{})
+
+	for _, tfMapRaw := range d.Get("lf_tag").(*schema.Set).List() {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			managedKeys[tfMap["key"].(string)] = struct{}{}
+		}
+	}
+
+	tfList := flattenLFTagPairs(apiObjects, managedKeys)
+
+	if !d.IsNewResource() && len(tfList) == 0 {
+		log.Printf("[WARN] Lake Formation Resource LF-Tags (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("lf_tag", tfList); err != nil {
+		return fmt.Errorf("error setting lf_tag: %w", err)
+	}
+
+	return nil
+}
+
+func resourceResourceLFTagsUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LakeFormationConn
+
+	if d.HasChange("lf_tag") {
+		o, n := d.GetChange("lf_tag")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		// A Data Catalog resource can only have one value per LF-Tag key, so a
+		// changed value is handled by removing the old pair and adding the new one.
+		if del := os.Difference(ns); del.Len() > 0 {
+			input := &lakeformation.RemoveLFTagsFromResourceInput{
+				LFTags:   expandLFTagPairs(del.List()),
+				Resource: expandResourceLFTagsResource(d),
+			}
+
+			if v, ok := d.GetOk("catalog_id"); ok {
+				input.CatalogId = aws.String(v.(string))
+			}
+
+			if err := removeLFTagsFromResource(conn, input); err != nil {
+				return fmt.Errorf("error updating Lake Formation Resource LF-Tags (%s): %w", d.Id(), err)
+			}
+		}
+
+		if add := ns.Difference(os); add.Len() > 0 {
+			input := &lakeformation.AddLFTagsToResourceInput{
+				LFTags:   expandLFTagPairs(add.List()),
+				Resource: expandResourceLFTagsResource(d),
+			}
+
+			if v, ok := d.GetOk("catalog_id"); ok {
+				input.CatalogId = aws.String(v.(string))
+			}
+
+			if err := addLFTagsToResource(conn, input); err != nil {
+				return fmt.Errorf("error updating Lake Formation Resource LF-Tags (%s): %w", d.Id(), err)
+			}
+		}
+	}
+
+	return resourceResourceLFTagsRead(d, meta)
+}
+
+func resourceResourceLFTagsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LakeFormationConn
+
+	input := &lakeformation.RemoveLFTagsFromResourceInput{
+		LFTags:   expandLFTagPairs(d.Get("lf_tag").(*schema.Set).List()),
+		Resource: expandResourceLFTagsResource(d),
+	}
+
+	if v, ok := d.GetOk("catalog_id"); ok {
+		input.CatalogId = aws.String(v.(string))
+	}
+
+	if len(input.LFTags) == 0 {
+		return nil
+	}
+
+	err := removeLFTagsFromResource(conn, input)
+
+	if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Lake Formation Resource LF-Tags (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func addLFTagsToResource(conn *lakeformation.LakeFormation, input *lakeformation.AddLFTagsToResourceInput) error {
+	log.Printf("[DEBUG] Adding Lake Formation LF-Tags to resource: %s", input)
+	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(tfiam.PropagationTimeout, func() (interface{}, error) {
+		return conn.AddLFTagsToResource(input)
+	}, lakeformation.ErrCodeConcurrentModificationException)
+
+	if err != nil {
+		return err
+	}
+
+	if output, ok := outputRaw.(*lakeformation.AddLFTagsToResourceOutput); ok && output != nil {
+		return lfTagErrors(output.Failures)
+	}
+
+	return nil
+}
+
+func removeLFTagsFromResource(conn *lakeformation.LakeFormation, input *lakeformation.RemoveLFTagsFromResourceInput) error {
+	log.Printf("[DEBUG] Removing Lake Formation LF-Tags from resource: %s", input)
+	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(tfiam.PropagationTimeout, func() (interface{}, error) {
+		return conn.RemoveLFTagsFromResource(input)
+	}, lakeformation.ErrCodeConcurrentModificationException)
+
+	if err != nil {
+		return err
+	}
+
+	if output, ok := outputRaw.(*lakeformation.RemoveLFTagsFromResourceOutput); ok && output != nil {
+		return lfTagErrors(output.Failures)
+	}
+
+	return nil
+}
+
+func lfTagErrors(apiObjects []*lakeformation.LFTagError) error {
+	var errs *multierror.Error
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil || apiObject.Error == nil {
+			continue
+		}
+
+		var key string
+		if apiObject.LFTag != nil {
+			key = aws.StringValue(apiObject.LFTag.TagKey)
+		}
+
+		errs = multierror.Append(errs, fmt.Errorf("LF-Tag (%s): %s: %s", key, aws.StringValue(apiObject.Error.ErrorCode), aws.StringValue(apiObject.Error.ErrorMessage)))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func expandResourceLFTagsResource(d *schema.ResourceData) *lakeformation.Resource {
+	apiObject := &lakeformation.Resource{}
+
+	if v, ok := d.GetOk("database"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject.Database = ExpandDatabaseResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("table"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject.Table = ExpandTableResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("table_with_columns"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject.TableWithColumns = expandLakeFormationTableWithColumnsResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandLFTagPair(tfMap map[string]interface{}) *lakeformation.LFTagPair {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &lakeformation.LFTagPair{}
+
+	if v, ok := tfMap["catalog_id"].(string); ok && v != "" {
+		apiObject.CatalogId = aws.String(v)
+	}
+
+	if v, ok := tfMap["key"].(string); ok && v != "" {
+		apiObject.TagKey = aws.String(v)
+	}
+
+	if v, ok := tfMap["value"].(string); ok && v != "" {
+		apiObject.TagValues = aws.StringSlice([]string{v})
+	}
+
+	return apiObject
+}
+
+func expandLFTagPairs(tfList []interface{}) []*lakeformation.LFTagPair {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*lakeformation.LFTagPair
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandLFTagPair(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenLFTagPairs(apiObjects []*lakeformation.LFTagPair, managedKeys map[string]struct{}) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		key := aws.StringValue(apiObject.TagKey)
+
+		if _, ok := managedKeys[key]; !ok {
+			continue
+		}
+
+		for _, value := range apiObject.TagValues {
+			tfList = append(tfList, map[string]interface{}{
+				"catalog_id": aws.StringValue(apiObject.CatalogId),
+				"key":        key,
+				"value":      aws.StringValue(value),
+			})
+		}
+	}
+
+	return tfList
+}
+
+func lfTagPairHash(v interface{}) int {
+	tfMap, ok := v.(map[string]interface{})
+
+	if !ok {
+		return 0
+	}
+
+	// catalog_id is intentionally omitted as it is computed when not configured.
+	return create.StringHashcode(fmt.Sprintf("%s:%s", tfMap["key"].(string), tfMap["value"].(string)))
+}
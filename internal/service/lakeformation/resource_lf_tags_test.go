@@ -0,0 +1,264 @@
+package lakeformation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/nij4t/terraform-provider-aws/internal/acctest"
+	"github.com/nij4t/terraform-provider-aws/internal/conns"
+)
+
+func testAccResourceLFTags_database(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_resource_lf_tags.test"
+	databaseName := "aws_glue_catalog_database.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(lakeformation.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckResourceLFTagsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceLFTagsConfig_database(rName, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceLFTagsExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "database.0.name", databaseName, "name"),
+					resource.TestCheckResourceAttr(resourceName, "lf_tag.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "lf_tag.*", map[string]string{
+						"key":   rName,
+						"value": "value1",
+					}),
+				),
+			},
+			{
+				Config: testAccResourceLFTagsConfig_database(rName, "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceLFTagsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "lf_tag.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "lf_tag.*", map[string]string{
+						"key":   rName,
+						"value": "value2",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceLFTags_table(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_resource_lf_tags.test"
+	tableName := "aws_glue_catalog_table.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(lakeformation.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckResourceLFTagsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceLFTagsConfig_table(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceLFTagsExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "table.0.database_name", tableName, "database_name"),
+					resource.TestCheckResourceAttrPair(resourceName, "table.0.name", tableName, "name"),
+					resource.TestCheckResourceAttr(resourceName, "lf_tag.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "lf_tag.*", map[string]string{
+						"key":   rName,
+						"value": "value",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceLFTags_tableWithColumns(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_resource_lf_tags.test"
+	tableName := "aws_glue_catalog_table.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(lakeformation.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckResourceLFTagsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceLFTagsConfig_tableWithColumns(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceLFTagsExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "table_with_columns.0.name", tableName, "name"),
+					resource.TestCheckResourceAttr(resourceName, "table_with_columns.0.column_names.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "lf_tag.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "lf_tag.*", map[string]string{
+						"key":   rName,
+						"value": "value",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckResourceLFTagsDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LakeFormationConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lakeformation_resource_lf_tags" {
+			continue
+		}
+
+		// Tags on tables and columns are removed along with the table itself.
+		if rs.Primary.Attributes["database.#"] != "1" {
+			continue
+		}
+
+		input := &lakeformation.GetResourceLFTagsInput{
+			Resource: &lakeformation.Resource{
+				Database: &lakeformation.DatabaseResource{
+					Name: aws.String(rs.Primary.Attributes["database.0.name"]),
+				},
+			},
+			ShowAssignedLFTags: aws.Bool(true),
+		}
+
+		output, err := conn.GetResourceLFTags(input)
+
+		if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil && len(output.LFTagOnDatabase) > 0 {
+			return fmt.Errorf("Lake Formation Resource LF-Tags %s still exist", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckResourceLFTagsExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lake Formation Resource LF-Tags ID is set")
+		}
+
+		if rs.Primary.Attributes["lf_tag.#"] == "0" {
+			return fmt.Errorf("Lake Formation Resource LF-Tags (%s) has no LF-Tags", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccResourceLFTagsConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_iam_session_context" "current" {
+  arn = data.aws_caller_identity.current.arn
+}
+
+resource "aws_lakeformation_data_lake_settings" "test" {
+  admins = [data.aws_iam_session_context.current.issuer_arn]
+}
+
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+
+resource "aws_glue_catalog_table" "test" {
+  name          = %[1]q
+  database_name = aws_glue_catalog_database.test.name
+
+  storage_descriptor {
+    columns {
+      name = "event"
+      type = "string"
+    }
+
+    columns {
+      name = "timestamp"
+      type = "date"
+    }
+
+    columns {
+      name = "value"
+      type = "double"
+    }
+  }
+}
+
+resource "aws_lakeformation_lf_tag" "test" {
+  key    = %[1]q
+  values = ["value", "value1", "value2"]
+
+  # for consistency, ensure that admins are setup before testing
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+`, rName)
+}
+
+func testAccResourceLFTagsConfig_database(rName, value string) string {
+	return acctest.ConfigCompose(testAccResourceLFTagsConfig_base(rName), fmt.Sprintf(`
+resource "aws_lakeformation_resource_lf_tags" "test" {
+  database {
+    name = aws_glue_catalog_database.test.name
+  }
+
+  lf_tag {
+    key   = aws_lakeformation_lf_tag.test.key
+    value = %[1]q
+  }
+}
+`, value))
+}
+
+func testAccResourceLFTagsConfig_table(rName string) string {
+	return acctest.ConfigCompose(testAccResourceLFTagsConfig_base(rName), `
+resource "aws_lakeformation_resource_lf_tags" "test" {
+  table {
+    database_name = aws_glue_catalog_table.test.database_name
+    name          = aws_glue_catalog_table.test.name
+  }
+
+  lf_tag {
+    key   = aws_lakeformation_lf_tag.test.key
+    value = "value"
+  }
+}
+`)
+}
+
+func testAccResourceLFTagsConfig_tableWithColumns(rName string) string {
+	return acctest.ConfigCompose(testAccResourceLFTagsConfig_base(rName), `
+resource "aws_lakeformation_resource_lf_tags" "test" {
+  table_with_columns {
+    database_name = aws_glue_catalog_table.test.database_name
+    name          = aws_glue_catalog_table.test.name
+    column_names  = ["event", "timestamp"]
+  }
+
+  lf_tag {
+    key   = aws_lakeformation_lf_tag.test.key
+    value = "value"
+  }
+}
+`)
+}
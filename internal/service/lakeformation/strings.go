@@ -31,3 +31,22 @@ func StringSlicesEqual(s1, s2 []*string) bool {
 
 	return reflect.DeepEqual(v1, v2)
 }
+
+// StringSlicesDifference returns the elements of s1 that are not in s2.
+func StringSlicesDifference(s1, s2 []*string) []*string {
+	m := make(map[string]struct{}, len(s2))
+
+	for _, v := range s2 {
+		m[aws.StringValue(v)] = struct{}{}
+	}
+
+	diff := make([]*string, 0)
+
+	for _, v := range s1 {
+		if _, ok := m[aws.StringValue(v)]; !ok {
+			diff = append(diff, v)
+		}
+	}
+
+	return diff
+}
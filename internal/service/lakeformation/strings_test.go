@@ -118,3 +118,45 @@ func TestStringSlicesEqual(t *testing.T) {
 		}
 	}
 }
+
+func TestStringSlicesDifference(t *testing.T) {
+	testCases := []struct {
+		s1       []string
+		s2       []string
+		expected []string
+	}{
+		{
+			s1:       []string{"ALTER", "DROP", "SELECT"},
+			s2:       []string{"ALTER", "DROP", "SELECT"},
+			expected: []string{},
+		},
+		{
+			s1:       []string{"ALTER", "DROP", "SELECT"},
+			s2:       []string{"SELECT"},
+			expected: []string{"ALTER", "DROP"},
+		},
+		{
+			s1:       []string{"SELECT"},
+			s2:       []string{"ALTER", "DROP", "SELECT"},
+			expected: []string{},
+		},
+		{
+			s1:       []string{"DESCRIBE", "INSERT"},
+			s2:       []string{},
+			expected: []string{"DESCRIBE", "INSERT"},
+		},
+		{
+			s1:       []string{},
+			s2:       []string{"DESCRIBE"},
+			expected: []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		got := aws.StringValueSlice(tflakeformation.StringSlicesDifference(aws.StringSlice(tc.s1), aws.StringSlice(tc.s2)))
+
+		if !tflakeformation.StringSlicesEqual(aws.StringSlice(got), aws.StringSlice(tc.expected)) {
+			t.Fatalf("difference of %v and %v: expected %v, got %v", tc.s1, tc.s2, tc.expected, got)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"regexp"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/nij4t/terraform-provider-aws/internal/verify"
 )
 
@@ -37,3 +38,8 @@ func validPrincipal(v interface{}, k string) (ws []string, errors []error) {
 
 	return ws, errors
 }
+
+var validLFTagValue = validation.All(
+	validation.StringLenBetween(1, 256),
+	validation.StringMatch(regexp.MustCompile(`^([\p{L}\p{Z}\p{N}_.:\*\/=+\-@%]*)$`), "must be a valid LF-Tag value"),
+)
@@ -1,6 +1,7 @@
 package lakeformation
 
 import (
+	"strings"
 	"testing"
 )
 
@@ -54,3 +55,32 @@ func TestValidPrincipal(t *testing.T) {
 		}
 	}
 }
+
+func TestValidLFTagValue(t *testing.T) {
+	validValues := []string{
+		"*",
+		"private",
+		"Public Data",
+		"pii:email",
+		"a/b=c+d-e@f%g",
+	}
+	for _, v := range validValues {
+		_, errors := validLFTagValue(v, "values")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid LF-Tag value: %q", v, errors)
+		}
+	}
+
+	invalidValues := []string{
+		"",
+		"semi;colon",
+		"quote\"d",
+		strings.Repeat("a", 257),
+	}
+	for _, v := range invalidValues {
+		_, errors := validLFTagValue(v, "values")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid LF-Tag value", v)
+		}
+	}
+}
@@ -2,6 +2,8 @@ package lambda
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"log"
@@ -14,6 +16,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -21,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/nij4t/terraform-provider-aws/internal/conns"
 	"github.com/nij4t/terraform-provider-aws/internal/flex"
+	tfservicequotas "github.com/nij4t/terraform-provider-aws/internal/service/servicequotas"
 	tftags "github.com/nij4t/terraform-provider-aws/internal/tags"
 	"github.com/nij4t/terraform-provider-aws/internal/tfresource"
 	"github.com/nij4t/terraform-provider-aws/internal/verify"
@@ -79,6 +83,18 @@ func ResourceFunction() *schema.Resource {
 				Optional:      true,
 				ConflictsWith: []string{"filename", "image_uri"},
 			},
+			"s3_object_code_sha256": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"s3_object_deployed_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"s3_object_etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"image_uri": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -333,8 +349,10 @@ func ResourceFunction() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			checkHandlerRuntimeForZipFunction,
+			checkFunctionCodeDrift,
 			updateComputedAttributesOnPublish,
 			verify.SetTagsDiff,
+			tfservicequotas.QuotaCheckCustomizeDiff(concurrentExecutionsQuotaCheck),
 		),
 	}
 }
@@ -350,6 +368,97 @@ func checkHandlerRuntimeForZipFunction(_ context.Context, d *schema.ResourceDiff
 	return nil
 }
 
+// checkFunctionCodeDrift plans a code update when the deployment package no
+// longer matches the code deployed to the function. A local file is compared
+// against the deployed CodeSha256 unless source_code_hash is configured. For
+// an S3 object, the deployed CodeSha256 is compared against the one recorded
+// when the object was deployed and, unless s3_object_version is configured,
+// the object is compared against the ETag recorded at that deployment.
+func checkFunctionCodeDrift(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	rawConfig := d.GetRawConfig()
+
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil
+	}
+
+	if v, ok := d.GetOk("filename"); ok {
+		if d.HasChange("filename") || !d.NewValueKnown("filename") || !rawConfig.GetAttr("source_code_hash").IsNull() {
+			return nil
+		}
+
+		hash, err := fileSourceCodeHash(v.(string))
+
+		if err != nil {
+			log.Printf("[WARN] Unable to check Lambda Function (%s) deployment package (%s) for drift: %s", d.Id(), v.(string), err)
+			return nil
+		}
+
+		if hash != d.Get("source_code_hash").(string) {
+			return d.SetNew("source_code_hash", hash)
+		}
+
+		return nil
+	}
+
+	bucket, ok := d.GetOk("s3_bucket")
+
+	if !ok {
+		return nil
+	}
+
+	versionConfigured := !rawConfig.GetAttr("s3_object_version").IsNull()
+
+	if d.HasChange("s3_bucket") || d.HasChange("s3_key") || d.HasChange("s3_object_version") {
+		for _, k := range []string{"s3_object_code_sha256", "s3_object_deployed_version", "s3_object_etag"} {
+			if err := d.SetNewComputed(k); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	// Code deployed outside of Terraform no longer matches the code deployed from the object.
+	// source_code_hash may be configured, so compare the function's CodeSha256 in state.
+	if recorded := d.Get("s3_object_code_sha256").(string); recorded != "" {
+		if deployed, _ := d.GetChange("source_code_hash"); deployed.(string) != recorded {
+			return d.SetNewComputed("s3_object_code_sha256")
+		}
+	}
+
+	// Without a recorded ETag, e.g. after import, there is nothing to compare against.
+	if versionConfigured || d.Get("s3_object_etag").(string) == "" {
+		return nil
+	}
+
+	key := d.Get("s3_key").(string)
+	output, err := meta.(*conns.AWSClient).S3Conn.HeadObject(&s3.HeadObjectInput{
+		Bucket: aws.String(bucket.(string)),
+		Key:    aws.String(key),
+	})
+
+	if err != nil {
+		log.Printf("[WARN] Unable to check Lambda Function (%s) deployment package (s3://%s/%s) for drift: %s", d.Id(), bucket.(string), key, err)
+		return nil
+	}
+
+	if etag := aws.StringValue(output.ETag); etag != d.Get("s3_object_etag").(string) {
+		if err := d.SetNew("s3_object_etag", etag); err != nil {
+			return err
+		}
+
+		if v := aws.StringValue(output.VersionId); v != "" {
+			return d.SetNew("s3_object_deployed_version", v)
+		}
+	}
+
+	return nil
+}
+
 func updateComputedAttributesOnPublish(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	configChanged := hasConfigChanges(d)
 	functionCodeUpdated := needsFunctionCodeUpdate(d)
@@ -530,8 +639,11 @@ func resourceFunctionCreate(d *schema.ResourceData, meta interface{}) error {
 		params.Tags = Tags(tags.IgnoreAWS())
 	}
 
+	var function *lambda.FunctionConfiguration
+
 	err := resource.Retry(lambdaFunctionCreateTimeout, func() *resource.RetryError { // nosem: helper-schema-resource-Retry-without-TimeoutError-check
-		_, err := conn.CreateFunction(params)
+		var err error
+		function, err = conn.CreateFunction(params)
 
 		if tfawserr.ErrMessageContains(err, lambda.ErrCodeInvalidParameterValueException, "The role defined for the function cannot be assumed by Lambda") {
 			log.Printf("[DEBUG] Received %s, retrying CreateFunction", err)
@@ -561,7 +673,7 @@ func resourceFunctionCreate(d *schema.ResourceData, meta interface{}) error {
 	})
 
 	if tfresource.TimedOut(err) {
-		_, err = conn.CreateFunction(params)
+		function, err = conn.CreateFunction(params)
 	}
 
 	if err != nil {
@@ -570,7 +682,8 @@ func resourceFunctionCreate(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		err := resource.Retry(lambdaFunctionExtraThrottlingTimeout, func() *resource.RetryError {
-			_, err := conn.CreateFunction(params)
+			var err error
+			function, err = conn.CreateFunction(params)
 
 			if tfawserr.ErrMessageContains(err, lambda.ErrCodeInvalidParameterValueException, "throttled by EC2") {
 				log.Printf("[DEBUG] Received %s, retrying CreateFunction", err)
@@ -585,7 +698,7 @@ func resourceFunctionCreate(d *schema.ResourceData, meta interface{}) error {
 		})
 
 		if tfresource.TimedOut(err) {
-			_, err = conn.CreateFunction(params)
+			function, err = conn.CreateFunction(params)
 		}
 
 		if err != nil {
@@ -599,6 +712,8 @@ func resourceFunctionCreate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error waiting for Lambda Function (%s) creation: %w", d.Id(), err)
 	}
 
+	setFunctionCodeS3ObjectAttributes(d, meta, aws.StringValue(function.CodeSha256))
+
 	if reservedConcurrentExecutions >= 0 {
 
 		log.Printf("[DEBUG] Setting Concurrency to %d for the Lambda Function %s", reservedConcurrentExecutions, functionName)
@@ -931,6 +1046,8 @@ func needsFunctionCodeUpdate(d verify.ResourceDiffer) bool {
 		d.HasChange("s3_bucket") ||
 		d.HasChange("s3_key") ||
 		d.HasChange("s3_object_version") ||
+		d.HasChange("s3_object_code_sha256") ||
+		d.HasChange("s3_object_etag") ||
 		d.HasChange("image_uri") ||
 		d.HasChange("architectures")
 }
@@ -1183,7 +1300,7 @@ func resourceFunctionUpdate(d *schema.ResourceData, meta interface{}) error {
 
 		log.Printf("[DEBUG] Send Update Lambda Function Code request: %#v", codeReq)
 
-		_, err := conn.UpdateFunctionCode(codeReq)
+		output, err := conn.UpdateFunctionCode(codeReq)
 		if err != nil {
 			return fmt.Errorf("error modifying Lambda Function (%s) Code: %w", d.Id(), err)
 		}
@@ -1191,6 +1308,8 @@ func resourceFunctionUpdate(d *schema.ResourceData, meta interface{}) error {
 		if err := waitForLambdaFunctionUpdate(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return fmt.Errorf("error waiting for Lambda Function (%s) code update: %w", d.Id(), err)
 		}
+
+		setFunctionCodeS3ObjectAttributes(d, meta, aws.StringValue(output.CodeSha256))
 	}
 
 	if d.HasChange("reserved_concurrent_executions") {
@@ -1252,13 +1371,8 @@ func resourceFunctionUpdate(d *schema.ResourceData, meta interface{}) error {
 			return fmt.Errorf("error publishing Lambda Function (%s) version: %w", d.Id(), err)
 		}
 
-		err = conn.WaitUntilFunctionUpdated(&lambda.GetFunctionConfigurationInput{
-			FunctionName: output.FunctionArn,
-			Qualifier:    output.Version,
-		})
-
-		if err != nil {
-			return fmt.Errorf("while waiting for function (%s) update: %w", d.Id(), err)
+		if err := waitForLambdaFunctionUpdate(conn, aws.StringValue(output.FunctionArn), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for Lambda Function (%s) version (%s) update: %w", d.Id(), aws.StringValue(output.Version), err)
 		}
 	}
 
@@ -1278,6 +1392,61 @@ func loadFileContent(v string) ([]byte, error) {
 	return fileContent, nil
 }
 
+// fileSourceCodeHash returns the base64-encoded SHA256 hash of a local
+// deployment package, as reported by the Lambda API in CodeSha256
+func fileSourceCodeHash(v string) (string, error) {
+	// Grab an exclusive lock so that we're only reading one function into
+	// memory at a time.
+	conns.GlobalMutexKV.Lock(awsMutexLambdaKey)
+	defer conns.GlobalMutexKV.Unlock(awsMutexLambdaKey)
+
+	content, err := loadFileContent(v)
+
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(content)
+
+	return base64.StdEncoding.EncodeToString(hash[:]), nil
+}
+
+// setFunctionCodeS3ObjectAttributes records the CodeSha256 of the code deployed
+// from an S3 object and the version and ETag of the object, so that later plans
+// can detect code deployed outside of Terraform and an object that is overwritten in place
+func setFunctionCodeS3ObjectAttributes(d *schema.ResourceData, meta interface{}, codeSha256 string) {
+	bucket, ok := d.GetOk("s3_bucket")
+
+	if !ok {
+		d.Set("s3_object_code_sha256", "")
+		return
+	}
+
+	d.Set("s3_object_code_sha256", codeSha256)
+
+	key := d.Get("s3_key").(string)
+	input := &s3.HeadObjectInput{
+		Bucket: aws.String(bucket.(string)),
+		Key:    aws.String(key),
+	}
+
+	if v, ok := d.GetOk("s3_object_version"); ok {
+		input.VersionId = aws.String(v.(string))
+	}
+
+	output, err := meta.(*conns.AWSClient).S3Conn.HeadObject(input)
+
+	if err != nil {
+		log.Printf("[WARN] Unable to read Lambda Function (%s) deployment package (s3://%s/%s), drift detection disabled: %s", d.Id(), bucket.(string), key, err)
+		d.Set("s3_object_deployed_version", "")
+		d.Set("s3_object_etag", "")
+		return
+	}
+
+	d.Set("s3_object_etag", output.ETag)
+	d.Set("s3_object_deployed_version", output.VersionId)
+}
+
 func readEnvironmentVariables(ev map[string]interface{}) map[string]string {
 	variables := make(map[string]string)
 	for k, v := range ev {
@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/signer"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -1624,7 +1625,7 @@ func TestAccLambdaFunction_s3(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"s3_bucket", "s3_key", "publish"},
+				ImportStateVerifyIgnore: []string{"s3_bucket", "s3_key", "s3_object_code_sha256", "s3_object_deployed_version", "s3_object_etag", "publish"},
 			},
 		},
 	})
@@ -1758,6 +1759,56 @@ func TestAccLambdaFunction_LocalUpdate_nameOnly(t *testing.T) {
 	})
 }
 
+func TestAccLambdaFunction_LocalUpdate_codeDrift(t *testing.T) {
+	var conf lambda.GetFunctionOutput
+
+	rString := sdkacctest.RandString(8)
+	funcName := fmt.Sprintf("tf_acc_lambda_func_local_upd_drift_%s", rString)
+	roleName := fmt.Sprintf("tf_acc_role_lambda_func_local_upd_drift_%s", rString)
+	resourceName := "aws_lambda_function.test"
+
+	path, zipFile, err := createTempFile("lambda_localUpdate_drift")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(path)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lambda.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckLambdaFunctionDestroy,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					if err := testAccCreateZipFromFiles(map[string]string{"test-fixtures/lambda_func.js": "lambda.js"}, zipFile); err != nil {
+						t.Fatalf("error creating zip from files: %s", err)
+					}
+				},
+				Config: testAccFunctionConfig_local_name_only(path, roleName, funcName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(resourceName, funcName, &conf),
+					testAccCheckSourceCodeHash(&conf, "8DPiX+G1l2LQ8hjBkwRchQFf1TSCEvPrYGRKlM9UoyY="),
+				),
+			},
+			{
+				// Overwrite the deployment package without changing its path.
+				PreConfig: func() {
+					if err := testAccCreateZipFromFiles(map[string]string{"test-fixtures/lambda_func_modified.js": "lambda.js"}, zipFile); err != nil {
+						t.Fatalf("error creating zip from files: %s", err)
+					}
+				},
+				Config: testAccFunctionConfig_local_name_only(path, roleName, funcName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(resourceName, funcName, &conf),
+					testAccCheckSourceCodeHash(&conf, "0tdaP9H9hsk9c2CycSwOG/sa/x5JyAmSYunA/ce99Pg="),
+					resource.TestCheckResourceAttr(resourceName, "source_code_hash", "0tdaP9H9hsk9c2CycSwOG/sa/x5JyAmSYunA/ce99Pg="),
+				),
+			},
+		},
+	})
+}
+
 func TestAccLambdaFunction_S3Update_basic(t *testing.T) {
 	var conf lambda.GetFunctionOutput
 
@@ -1800,7 +1851,7 @@ func TestAccLambdaFunction_S3Update_basic(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"filename", "publish", "s3_bucket", "s3_key", "s3_object_version"},
+				ImportStateVerifyIgnore: []string{"filename", "publish", "s3_bucket", "s3_key", "s3_object_code_sha256", "s3_object_deployed_version", "s3_object_etag", "s3_object_version"},
 			},
 			{
 				PreConfig: func() {
@@ -1863,7 +1914,7 @@ func TestAccLambdaFunction_S3Update_unversioned(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"filename", "publish", "s3_bucket", "s3_key"},
+				ImportStateVerifyIgnore: []string{"filename", "publish", "s3_bucket", "s3_key", "s3_object_code_sha256", "s3_object_deployed_version", "s3_object_etag"},
 			},
 			{
 				PreConfig: func() {
@@ -1884,6 +1935,121 @@ func TestAccLambdaFunction_S3Update_unversioned(t *testing.T) {
 	})
 }
 
+func TestAccLambdaFunction_S3Update_objectOverwritten(t *testing.T) {
+	var conf lambda.GetFunctionOutput
+
+	path, zipFile, err := createTempFile("lambda_s3Update")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(path)
+
+	rString := sdkacctest.RandString(8)
+	bucketName := fmt.Sprintf("tf-acc-bucket-lambda-func-s3-upd-over-%s", rString)
+	funcName := fmt.Sprintf("tf_acc_lambda_func_s3_upd_over_%s", rString)
+	roleName := fmt.Sprintf("tf_acc_role_lambda_func_s3_upd_over_%s", rString)
+	resourceName := "aws_lambda_function.test"
+	key := "lambda-func.zip"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lambda.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckLambdaFunctionDestroy,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					if err := testAccCreateZipFromFiles(map[string]string{"test-fixtures/lambda_func.js": "lambda.js"}, zipFile); err != nil {
+						t.Fatalf("error creating zip from files: %s", err)
+					}
+				},
+				Config: testAccFunctionConfig_s3_latestVersion(bucketName, key, path, roleName, funcName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(resourceName, funcName, &conf),
+					testAccCheckSourceCodeHash(&conf, "8DPiX+G1l2LQ8hjBkwRchQFf1TSCEvPrYGRKlM9UoyY="),
+					resource.TestCheckResourceAttr(resourceName, "s3_object_version", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "s3_object_deployed_version", "aws_s3_bucket_object.o", "version_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "s3_object_etag"),
+				),
+			},
+			{
+				// Overwrite the S3 object outside of Terraform.
+				PreConfig: func() {
+					if err := testAccCreateZipFromFiles(map[string]string{"test-fixtures/lambda_func_modified.js": "lambda.js"}, zipFile); err != nil {
+						t.Fatalf("error creating zip from files: %s", err)
+					}
+
+					if err := testAccPutFunctionCodeS3Object(bucketName, key, path); err != nil {
+						t.Fatalf("error uploading deployment package: %s", err)
+					}
+				},
+				Config: testAccFunctionConfig_s3_latestVersion(bucketName, key, path, roleName, funcName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(resourceName, funcName, &conf),
+					testAccCheckSourceCodeHash(&conf, "0tdaP9H9hsk9c2CycSwOG/sa/x5JyAmSYunA/ce99Pg="),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaFunction_S3Update_codeDeployedOutsideTerraform(t *testing.T) {
+	var conf lambda.GetFunctionOutput
+
+	path, zipFile, err := createTempFile("lambda_s3Update")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(path)
+
+	rString := sdkacctest.RandString(8)
+	bucketName := fmt.Sprintf("tf-acc-bucket-lambda-func-s3-upd-out-%s", rString)
+	funcName := fmt.Sprintf("tf_acc_lambda_func_s3_upd_out_%s", rString)
+	roleName := fmt.Sprintf("tf_acc_role_lambda_func_s3_upd_out_%s", rString)
+	resourceName := "aws_lambda_function.test"
+	key := "lambda-func.zip"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lambda.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckLambdaFunctionDestroy,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					if err := testAccCreateZipFromFiles(map[string]string{"test-fixtures/lambda_func.js": "lambda.js"}, zipFile); err != nil {
+						t.Fatalf("error creating zip from files: %s", err)
+					}
+				},
+				Config: testAccFunctionConfig_s3(bucketName, key, path, roleName, funcName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(resourceName, funcName, &conf),
+					testAccCheckSourceCodeHash(&conf, "8DPiX+G1l2LQ8hjBkwRchQFf1TSCEvPrYGRKlM9UoyY="),
+					resource.TestCheckResourceAttr(resourceName, "s3_object_code_sha256", "8DPiX+G1l2LQ8hjBkwRchQFf1TSCEvPrYGRKlM9UoyY="),
+				),
+			},
+			{
+				// Deploy other code outside of Terraform; the configured object version is redeployed.
+				PreConfig: func() {
+					if err := testAccCreateZipFromFiles(map[string]string{"test-fixtures/lambda_func_modified.js": "lambda.js"}, zipFile); err != nil {
+						t.Fatalf("error creating zip from files: %s", err)
+					}
+
+					if err := testAccUpdateFunctionCodeZipFile(funcName, path); err != nil {
+						t.Fatalf("error updating function code: %s", err)
+					}
+				},
+				Config: testAccFunctionConfig_s3(bucketName, key, path, roleName, funcName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(resourceName, funcName, &conf),
+					testAccCheckSourceCodeHash(&conf, "8DPiX+G1l2LQ8hjBkwRchQFf1TSCEvPrYGRKlM9UoyY="),
+					resource.TestCheckResourceAttr(resourceName, "s3_object_code_sha256", "8DPiX+G1l2LQ8hjBkwRchQFf1TSCEvPrYGRKlM9UoyY="),
+				),
+			},
+		},
+	})
+}
+
 func TestAccLambdaFunction_tags(t *testing.T) {
 	var conf lambda.GetFunctionOutput
 
@@ -2129,6 +2295,46 @@ func testAccCheckFunctionVersion(function *lambda.GetFunctionOutput, expectedVer
 	}
 }
 
+func testAccPutFunctionCodeS3Object(bucket, key, path string) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = conn.PutObject(&s3.PutObjectInput{
+		Body:   file,
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+
+	return err
+}
+
+func testAccUpdateFunctionCodeZipFile(funcName, path string) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn
+
+	zipFile, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.UpdateFunctionCode(&lambda.UpdateFunctionCodeInput{
+		FunctionName: aws.String(funcName),
+		ZipFile:      zipFile,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return conn.WaitUntilFunctionUpdated(&lambda.GetFunctionConfigurationInput{
+		FunctionName: aws.String(funcName),
+	})
+}
+
 func testAccCheckSourceCodeHash(function *lambda.GetFunctionOutput, expectedHash string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		c := function.Configuration
@@ -3333,6 +3539,59 @@ resource "aws_lambda_function" "test" {
 `, bucketName, key, path, path, roleName, funcName)
 }
 
+func testAccFunctionConfig_s3_latestVersion(bucketName, key, path, roleName, funcName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "artifacts" {
+  bucket        = "%s"
+  acl           = "private"
+  force_destroy = true
+
+  versioning {
+    enabled = true
+  }
+}
+
+resource "aws_s3_bucket_object" "o" {
+  bucket = aws_s3_bucket.artifacts.bucket
+  key    = "%s"
+  source = "%s"
+
+  lifecycle {
+    ignore_changes = [etag, version_id]
+  }
+}
+
+resource "aws_iam_role" "iam_for_lambda" {
+  name = "%s"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "lambda.amazonaws.com"
+      },
+      "Effect": "Allow",
+      "Sid": ""
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_lambda_function" "test" {
+  s3_bucket     = aws_s3_bucket_object.o.bucket
+  s3_key        = aws_s3_bucket_object.o.key
+  function_name = "%s"
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "exports.example"
+  runtime       = "nodejs12.x"
+}
+`, bucketName, key, path, roleName, funcName)
+}
+
 func testAccFunctionConfig_s3_unversioned_tpl(bucketName, roleName, funcName, key, path string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "artifacts" {
@@ -0,0 +1,62 @@
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nij4t/terraform-provider-aws/internal/conns"
+	tfservicequotas "github.com/nij4t/terraform-provider-aws/internal/service/servicequotas"
+)
+
+// minUnreservedConcurrentExecutions is the concurrency that Lambda keeps unreserved for functions without reserved concurrency.
+const minUnreservedConcurrentExecutions = 100
+
+// concurrentExecutionsQuotaCheck checks the Concurrent executions quota when planning reserved concurrency.
+var concurrentExecutionsQuotaCheck = tfservicequotas.QuotaCheck{
+	ServiceCode: "lambda",
+	QuotaCode:   "L-B99A9384",
+	Description: "Concurrent executions",
+	Reserved:    minUnreservedConcurrentExecutions,
+	Usage:       concurrentExecutionsQuotaUsage,
+	Planned:     concurrentExecutionsQuotaPlanned,
+}
+
+// concurrentExecutionsQuotaUsage returns the concurrency reserved by functions.
+func concurrentExecutionsQuotaUsage(ctx context.Context, client *conns.AWSClient) (float64, error) {
+	output, err := client.LambdaConn.GetAccountSettingsWithContext(ctx, &lambda.GetAccountSettingsInput{})
+
+	if err != nil {
+		return 0, err
+	}
+
+	if output == nil || output.AccountLimit == nil {
+		return 0, nil
+	}
+
+	limit := output.AccountLimit
+
+	return float64(aws.Int64Value(limit.ConcurrentExecutions) - aws.Int64Value(limit.UnreservedConcurrentExecutions)), nil
+}
+
+// concurrentExecutionsQuotaPlanned returns the increase in a function's reserved concurrency.
+func concurrentExecutionsQuotaPlanned(diff *schema.ResourceDiff) float64 {
+	if !diff.NewValueKnown("reserved_concurrent_executions") {
+		return 0
+	}
+
+	o, n := diff.GetChange("reserved_concurrent_executions")
+	oldReserved, newReserved := o.(int), n.(int)
+
+	// -1 removes any reserved concurrency.
+	if diff.Id() == "" || oldReserved < 0 {
+		oldReserved = 0
+	}
+
+	if newReserved < 0 {
+		newReserved = 0
+	}
+
+	return float64(newReserved - oldReserved)
+}
@@ -375,7 +375,7 @@ func resourceMacie2ClassificationJobCreate(ctx context.Context, d *schema.Resour
 
 	input := &macie2.CreateClassificationJobInput{
 		ClientToken:     aws.String(resource.UniqueId()),
-		Name:            aws.String(create.Name(d.Get("name").(string), d.Get("name_prefix").(string))),
+		Name:            aws.String(create.Name(meta.(*conns.AWSClient).NamingPolicy, d.Get("name").(string), d.Get("name_prefix").(string))),
 		JobType:         aws.String(d.Get("job_type").(string)),
 		S3JobDefinition: expandS3JobDefinition(d.Get("s3_job_definition").([]interface{})),
 	}
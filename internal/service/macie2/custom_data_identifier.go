@@ -119,7 +119,7 @@ func resourceMacie2CustomDataIdentifierCreate(ctx context.Context, d *schema.Res
 	if v, ok := d.GetOk("ignore_words"); ok {
 		input.IgnoreWords = flex.ExpandStringSet(v.(*schema.Set))
 	}
-	input.Name = aws.String(create.Name(d.Get("name").(string), d.Get("name_prefix").(string)))
+	input.Name = aws.String(create.Name(meta.(*conns.AWSClient).NamingPolicy, d.Get("name").(string), d.Get("name_prefix").(string)))
 	if v, ok := d.GetOk("description"); ok {
 		input.Description = aws.String(v.(string))
 	}
@@ -0,0 +1,67 @@
+package organizations
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	aiServicesOptOutPolicyOptIn  = "optIn"
+	aiServicesOptOutPolicyOptOut = "optOut"
+)
+
+func DataSourceAIServicesOptOutPolicyDocument() *schema.Resource {
+	// AI services opt-out policies only support @@assign.
+	childOperators := []string{
+		managementPolicyOperatorAssign,
+		managementPolicyOperatorNone,
+	}
+
+	return &schema.Resource{
+		Read: dataSourceAIServicesOptOutPolicyDocumentRead,
+
+		Schema: managementPolicyDocumentSchema(map[string]*schema.Schema{
+			"service": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"operators_allowed_for_child_policies": managementPolicyChildPolicyOperatorsSchema(childOperators),
+						"opt_out_policy": managementPolicyStringValueSchema(childOperators, validation.StringInSlice([]string{
+							aiServicesOptOutPolicyOptIn,
+							aiServicesOptOutPolicyOptOut,
+						}, false)),
+					},
+				},
+			},
+		}),
+	}
+}
+
+func dataSourceAIServicesOptOutPolicyDocumentRead(d *schema.ResourceData, meta interface{}) error {
+	doc := map[string]interface{}{}
+
+	if v, ok := d.GetOk("service"); ok && len(v.([]interface{})) > 0 {
+		services := map[string]interface{}{}
+
+		for _, tfMapRaw := range v.([]interface{}) {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			service := expandManagementPolicyNode(services, tfMap)
+
+			expandManagementPolicyStringValue(service, "opt_out_policy", tfMap["opt_out_policy"].([]interface{}))
+		}
+
+		doc["services"] = services
+	}
+
+	return setManagementPolicyDocument(d, doc)
+}
@@ -0,0 +1,92 @@
+package organizations_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/nij4t/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccOrganizationsAIServicesOptOutPolicyDocumentDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_organizations_aiservices_opt_out_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, organizations.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAIServicesOptOutPolicyDocumentDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "json", testAccAIServicesOptOutPolicyDocumentDataSourceExpectedJSON_basic),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOrganizationsAIServicesOptOutPolicyDocumentDataSource_invalidChildOperator(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, organizations.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAIServicesOptOutPolicyDocumentDataSourceConfig_invalidChildOperator,
+				ExpectError: regexp.MustCompile(`to be one of`),
+			},
+		},
+	})
+}
+
+const testAccAIServicesOptOutPolicyDocumentDataSourceConfig_basic = `
+data "aws_organizations_aiservices_opt_out_policy_document" "test" {
+  service {
+    name = "default"
+
+    opt_out_policy {
+      value = "optOut"
+    }
+  }
+
+  service {
+    name = "rekognition"
+
+    opt_out_policy {
+      value                                = "optIn"
+      operators_allowed_for_child_policies = ["@@none"]
+    }
+  }
+}
+`
+
+const testAccAIServicesOptOutPolicyDocumentDataSourceExpectedJSON_basic = `{
+  "services": {
+    "default": {
+      "opt_out_policy": {
+        "@@assign": "optOut"
+      }
+    },
+    "rekognition": {
+      "opt_out_policy": {
+        "@@assign": "optIn",
+        "@@operators_allowed_for_child_policies": ["@@none"]
+      }
+    }
+  }
+}`
+
+const testAccAIServicesOptOutPolicyDocumentDataSourceConfig_invalidChildOperator = `
+data "aws_organizations_aiservices_opt_out_policy_document" "test" {
+  service {
+    name = "default"
+
+    opt_out_policy {
+      value                                = "optOut"
+      operators_allowed_for_child_policies = ["@@append"]
+    }
+  }
+}
+`
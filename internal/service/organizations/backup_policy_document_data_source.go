@@ -0,0 +1,220 @@
+package organizations
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceBackupPolicyDocument() *schema.Resource {
+	childOperators := managementPolicyChildPolicyOperators()
+	valueOperators := managementPolicyValueOperators()
+
+	lifecycleSchema := &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"delete_after_days":               managementPolicyStringValueSchema(childOperators, validation.StringMatch(managementPolicyNumberRegexp, "must be a number")),
+				"move_to_cold_storage_after_days": managementPolicyStringValueSchema(childOperators, validation.StringMatch(managementPolicyNumberRegexp, "must be a number")),
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Read: dataSourceBackupPolicyDocumentRead,
+
+		Schema: managementPolicyDocumentSchema(map[string]*schema.Schema{
+			"plan": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"operators_allowed_for_child_policies": managementPolicyChildPolicyOperatorsSchema(childOperators),
+						"regions":                              managementPolicyListValueSchema(valueOperators, childOperators),
+						"rule": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"complete_backup_window_minutes": managementPolicyStringValueSchema(childOperators, validation.StringMatch(managementPolicyNumberRegexp, "must be a number")),
+									"copy_action": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"lifecycle": lifecycleSchema,
+												// May contain the $account variable, so isn't validated as an ARN.
+												"target_backup_vault_arn": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+									"enable_continuous_backup": managementPolicyStringValueSchema(childOperators, validation.StringInSlice([]string{"true", "false"}, false)),
+									"lifecycle":                lifecycleSchema,
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"operators_allowed_for_child_policies": managementPolicyChildPolicyOperatorsSchema(childOperators),
+									"schedule_expression":                  managementPolicyStringValueSchema(childOperators, nil),
+									"start_backup_window_minutes":          managementPolicyStringValueSchema(childOperators, validation.StringMatch(managementPolicyNumberRegexp, "must be a number")),
+									"target_backup_vault_name":             managementPolicyStringValueSchema(childOperators, nil),
+								},
+							},
+						},
+						"selection": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"iam_role_arn": managementPolicyStringValueSchema(childOperators, nil),
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"operators_allowed_for_child_policies": managementPolicyChildPolicyOperatorsSchema(childOperators),
+									"tag_key":                              managementPolicyStringValueSchema(childOperators, nil),
+									"tag_value":                            managementPolicyListValueSchema(valueOperators, childOperators),
+								},
+							},
+						},
+						"windows_vss": managementPolicyStringValueSchema(childOperators, validation.StringInSlice([]string{"enabled", "disabled"}, false)),
+					},
+				},
+			},
+		}),
+	}
+}
+
+func dataSourceBackupPolicyDocumentRead(d *schema.ResourceData, meta interface{}) error {
+	doc := map[string]interface{}{}
+
+	if v, ok := d.GetOk("plan"); ok && len(v.([]interface{})) > 0 {
+		plans := map[string]interface{}{}
+
+		for _, tfMapRaw := range v.([]interface{}) {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			plan := expandManagementPolicyNode(plans, tfMap)
+
+			expandManagementPolicyListValue(plan, "regions", tfMap["regions"].([]interface{}))
+
+			if v, ok := tfMap["rule"].([]interface{}); ok && len(v) > 0 {
+				plan["rules"] = expandBackupPolicyRules(v)
+			}
+
+			if v, ok := tfMap["selection"].([]interface{}); ok && len(v) > 0 {
+				plan["selections"] = map[string]interface{}{
+					"tags": expandBackupPolicySelectionTags(v),
+				}
+			}
+
+			if v, ok := tfMap["windows_vss"].([]interface{}); ok && len(v) > 0 {
+				ec2 := map[string]interface{}{}
+
+				expandManagementPolicyStringValue(ec2, "windows_vss", v)
+
+				plan["advanced_backup_settings"] = map[string]interface{}{
+					"ec2": ec2,
+				}
+			}
+		}
+
+		doc["plans"] = plans
+	}
+
+	return setManagementPolicyDocument(d, doc)
+}
+
+func expandBackupPolicyRules(tfList []interface{}) map[string]interface{} {
+	rules := map[string]interface{}{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		rule := expandManagementPolicyNode(rules, tfMap)
+
+		for _, key := range []string{"complete_backup_window_minutes", "enable_continuous_backup", "schedule_expression", "start_backup_window_minutes", "target_backup_vault_name"} {
+			expandManagementPolicyStringValue(rule, key, tfMap[key].([]interface{}))
+		}
+
+		expandBackupPolicyLifecycle(rule, tfMap["lifecycle"].([]interface{}))
+
+		if v, ok := tfMap["copy_action"].([]interface{}); ok && len(v) > 0 {
+			copyActions := map[string]interface{}{}
+
+			for _, tfMapRaw := range v {
+				tfMap, ok := tfMapRaw.(map[string]interface{})
+
+				if !ok {
+					continue
+				}
+
+				// Copy actions are keyed by their target vault ARN.
+				arn := tfMap["target_backup_vault_arn"].(string)
+				copyAction := map[string]interface{}{
+					"target_backup_vault_arn": map[string]interface{}{
+						managementPolicyOperatorAssign: arn,
+					},
+				}
+
+				expandBackupPolicyLifecycle(copyAction, tfMap["lifecycle"].([]interface{}))
+
+				copyActions[arn] = copyAction
+			}
+
+			rule["copy_actions"] = copyActions
+		}
+	}
+
+	return rules
+}
+
+func expandBackupPolicyLifecycle(doc map[string]interface{}, tfList []interface{}) {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	lifecycle := map[string]interface{}{}
+
+	expandManagementPolicyStringValue(lifecycle, "delete_after_days", tfMap["delete_after_days"].([]interface{}))
+	expandManagementPolicyStringValue(lifecycle, "move_to_cold_storage_after_days", tfMap["move_to_cold_storage_after_days"].([]interface{}))
+
+	doc["lifecycle"] = lifecycle
+}
+
+func expandBackupPolicySelectionTags(tfList []interface{}) map[string]interface{} {
+	tags := map[string]interface{}{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		tag := expandManagementPolicyNode(tags, tfMap)
+
+		expandManagementPolicyStringValue(tag, "iam_role_arn", tfMap["iam_role_arn"].([]interface{}))
+		expandManagementPolicyStringValue(tag, "tag_key", tfMap["tag_key"].([]interface{}))
+		expandManagementPolicyListValue(tag, "tag_value", tfMap["tag_value"].([]interface{}))
+	}
+
+	return tags
+}
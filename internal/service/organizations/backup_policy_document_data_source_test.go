@@ -0,0 +1,149 @@
+package organizations_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/nij4t/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccOrganizationsBackupPolicyDocumentDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_organizations_backup_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, organizations.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBackupPolicyDocumentDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "json", testAccBackupPolicyDocumentDataSourceExpectedJSON_basic),
+				),
+			},
+		},
+	})
+}
+
+const testAccBackupPolicyDocumentDataSourceConfig_basic = `
+data "aws_organizations_backup_policy_document" "test" {
+  plan {
+    name = "pii_backup_plan"
+
+    regions {
+      values = ["us-east-1", "eu-north-1"]
+    }
+
+    rule {
+      name = "hourly"
+
+      schedule_expression {
+        value = "cron(0 5/1 ? * * *)"
+      }
+
+      target_backup_vault_name {
+        value = "FortKnox"
+      }
+
+      lifecycle {
+        delete_after_days {
+          value = "2"
+        }
+      }
+
+      copy_action {
+        target_backup_vault_arn = "arn:aws:backup:us-west-2:$account:backup-vault:secondary_vault"
+
+        lifecycle {
+          delete_after_days {
+            value = "28"
+          }
+        }
+      }
+    }
+
+    selection {
+      name = "datatype"
+
+      iam_role_arn {
+        value = "arn:aws:iam::$account:role/MyIamRole"
+      }
+
+      tag_key {
+        value = "dataType"
+      }
+
+      tag_value {
+        values = ["PII"]
+      }
+    }
+
+    windows_vss {
+      value                                = "enabled"
+      operators_allowed_for_child_policies = ["@@none"]
+    }
+  }
+}
+`
+
+//lintignore:AWSAT003,AWSAT005
+const testAccBackupPolicyDocumentDataSourceExpectedJSON_basic = `{
+  "plans": {
+    "pii_backup_plan": {
+      "advanced_backup_settings": {
+        "ec2": {
+          "windows_vss": {
+            "@@assign": "enabled",
+            "@@operators_allowed_for_child_policies": ["@@none"]
+          }
+        }
+      },
+      "regions": {
+        "@@assign": ["us-east-1", "eu-north-1"]
+      },
+      "rules": {
+        "hourly": {
+          "copy_actions": {
+            "arn:aws:backup:us-west-2:$account:backup-vault:secondary_vault": {
+              "lifecycle": {
+                "delete_after_days": {
+                  "@@assign": "28"
+                }
+              },
+              "target_backup_vault_arn": {
+                "@@assign": "arn:aws:backup:us-west-2:$account:backup-vault:secondary_vault"
+              }
+            }
+          },
+          "lifecycle": {
+            "delete_after_days": {
+              "@@assign": "2"
+            }
+          },
+          "schedule_expression": {
+            "@@assign": "cron(0 5/1 ? * * *)"
+          },
+          "target_backup_vault_name": {
+            "@@assign": "FortKnox"
+          }
+        }
+      },
+      "selections": {
+        "tags": {
+          "datatype": {
+            "iam_role_arn": {
+              "@@assign": "arn:aws:iam::$account:role/MyIamRole"
+            },
+            "tag_key": {
+              "@@assign": "dataType"
+            },
+            "tag_value": {
+              "@@assign": ["PII"]
+            }
+          }
+        }
+      }
+    }
+  }
+}`
@@ -37,7 +37,7 @@ func ResourcePolicy() *schema.Resource {
 			"content": {
 				Type:             schema.TypeString,
 				Required:         true,
-				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+				DiffSuppressFunc: suppressEquivalentPolicyContentDiffs,
 				ValidateFunc:     validation.StringIsJSON,
 			},
 			"description": {
@@ -0,0 +1,358 @@
+package organizations
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nij4t/terraform-provider-aws/internal/create"
+	"github.com/nij4t/terraform-provider-aws/internal/verify"
+)
+
+// Management policies (tag, backup and AI services opt-out policies) share an
+// inheritance syntax in which every setting is a map holding a value-setting
+// operator and, optionally, the operators that child policies may use on it.
+// Reference: https://docs.aws.amazon.com/organizations/latest/userguide/orgs_manage_policies_inheritance_mgmt.html
+const (
+	managementPolicyOperatorAll    = "@@all"
+	managementPolicyOperatorAppend = "@@append"
+	managementPolicyOperatorAssign = "@@assign"
+	managementPolicyOperatorNone   = "@@none"
+	managementPolicyOperatorRemove = "@@remove"
+
+	managementPolicyOperatorsAllowedForChildPolicies = "@@operators_allowed_for_child_policies"
+)
+
+// Numbers in management policies are given as strings.
+var managementPolicyNumberRegexp = regexp.MustCompile(`^[0-9]+$`)
+
+func managementPolicyValueOperators() []string {
+	return []string{
+		managementPolicyOperatorAppend,
+		managementPolicyOperatorAssign,
+		managementPolicyOperatorRemove,
+	}
+}
+
+func managementPolicyChildPolicyOperators() []string {
+	return []string{
+		managementPolicyOperatorAll,
+		managementPolicyOperatorAppend,
+		managementPolicyOperatorAssign,
+		managementPolicyOperatorNone,
+		managementPolicyOperatorRemove,
+	}
+}
+
+func managementPolicyChildPolicyOperatorsSchema(operators []string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringInSlice(operators, false),
+		},
+	}
+}
+
+// managementPolicyStringValueSchema returns the schema for a setting whose
+// value is a single string that can only be set with @@assign.
+func managementPolicyStringValueSchema(childOperators []string, validateFunc schema.SchemaValidateFunc) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"operators_allowed_for_child_policies": managementPolicyChildPolicyOperatorsSchema(childOperators),
+				"value": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validateFunc,
+				},
+			},
+		},
+	}
+}
+
+// managementPolicyListValueSchema returns the schema for a setting whose
+// value is a list of strings that can be set with any of the given operators.
+func managementPolicyListValueSchema(operators, childOperators []string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"operator": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      managementPolicyOperatorAssign,
+					ValidateFunc: validation.StringInSlice(operators, false),
+				},
+				"operators_allowed_for_child_policies": managementPolicyChildPolicyOperatorsSchema(childOperators),
+				"values": {
+					Type:     schema.TypeList,
+					Required: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+// managementPolicyDocumentSchema adds the arguments and attributes common to
+// all management policy document data sources.
+func managementPolicyDocumentSchema(s map[string]*schema.Schema) map[string]*schema.Schema {
+	s["json"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+	s["override_policy_documents"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringIsJSON,
+		},
+	}
+	s["source_policy_documents"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringIsJSON,
+		},
+	}
+
+	return s
+}
+
+// setManagementPolicyDocument merges the source documents, the document built
+// from configuration and the override documents, in that order, and sets the
+// result as the data source's JSON.
+func setManagementPolicyDocument(d *schema.ResourceData, doc map[string]interface{}) error {
+	mergedDoc := map[string]interface{}{}
+
+	for i, v := range d.Get("source_policy_documents").([]interface{}) {
+		sourceDoc, err := unmarshalManagementPolicyDocument(v)
+
+		if err != nil {
+			return fmt.Errorf("error parsing source_policy_documents (%d): %w", i, err)
+		}
+
+		MergeManagementPolicyDocuments(mergedDoc, sourceDoc)
+	}
+
+	MergeManagementPolicyDocuments(mergedDoc, doc)
+
+	for i, v := range d.Get("override_policy_documents").([]interface{}) {
+		overrideDoc, err := unmarshalManagementPolicyDocument(v)
+
+		if err != nil {
+			return fmt.Errorf("error parsing override_policy_documents (%d): %w", i, err)
+		}
+
+		MergeManagementPolicyDocuments(mergedDoc, overrideDoc)
+	}
+
+	jsonDoc, err := json.MarshalIndent(mergedDoc, "", "  ")
+
+	if err != nil {
+		return err
+	}
+
+	jsonString := string(jsonDoc)
+
+	d.Set("json", jsonString)
+	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
+
+	return nil
+}
+
+func unmarshalManagementPolicyDocument(v interface{}) (map[string]interface{}, error) {
+	doc := map[string]interface{}{}
+
+	// An empty list element (e.g. a conditional document) is ignored.
+	s, ok := v.(string)
+
+	if !ok || s == "" {
+		return doc, nil
+	}
+
+	if err := json.Unmarshal([]byte(s), &doc); err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+// MergeManagementPolicyDocuments deep merges src into dst. A value-setting
+// operator in src replaces any value-setting operator in the same dst setting,
+// so that a setting never ends up with more than one.
+func MergeManagementPolicyDocuments(dst, src map[string]interface{}) {
+	for k, v := range src {
+		if isManagementPolicyValueOperator(k) {
+			for _, operator := range managementPolicyValueOperators() {
+				delete(dst, operator)
+			}
+
+			dst[k] = v
+
+			continue
+		}
+
+		srcMap, srcOk := v.(map[string]interface{})
+		dstMap, dstOk := dst[k].(map[string]interface{})
+
+		if srcOk && dstOk {
+			MergeManagementPolicyDocuments(dstMap, srcMap)
+
+			continue
+		}
+
+		dst[k] = v
+	}
+}
+
+func isManagementPolicyValueOperator(k string) bool {
+	for _, operator := range managementPolicyValueOperators() {
+		if k == operator {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ManagementPoliciesAreEquivalent returns whether two management policy
+// documents are semantically equal. Formatting and the order of operator
+// values (which are sets) are ignored.
+func ManagementPoliciesAreEquivalent(policy1, policy2 string) (bool, error) {
+	var doc1, doc2 interface{}
+
+	if err := json.Unmarshal([]byte(policy1), &doc1); err != nil {
+		return false, err
+	}
+
+	if err := json.Unmarshal([]byte(policy2), &doc2); err != nil {
+		return false, err
+	}
+
+	return reflect.DeepEqual(normalizeManagementPolicy(doc1), normalizeManagementPolicy(doc2)), nil
+}
+
+func normalizeManagementPolicy(v interface{}) interface{} {
+	m, ok := v.(map[string]interface{})
+
+	if !ok {
+		return v
+	}
+
+	for k, v := range m {
+		if l, ok := v.([]interface{}); ok && strings.HasPrefix(k, "@@") {
+			sort.Slice(l, func(i, j int) bool {
+				return fmt.Sprint(l[i]) < fmt.Sprint(l[j])
+			})
+		}
+
+		m[k] = normalizeManagementPolicy(v)
+	}
+
+	return m
+}
+
+// suppressEquivalentPolicyContentDiffs compares service control policies as
+// IAM policies and all other policy types as management policies.
+func suppressEquivalentPolicyContentDiffs(k, old, new string, d *schema.ResourceData) bool {
+	if d.Get("type").(string) == organizations.PolicyTypeServiceControlPolicy {
+		return verify.SuppressEquivalentPolicyDiffs(k, old, new, d)
+	}
+
+	equivalent, err := ManagementPoliciesAreEquivalent(old, new)
+
+	if err != nil {
+		return false
+	}
+
+	return equivalent
+}
+
+func expandManagementPolicyChildPolicyOperators(tfSet *schema.Set) []string {
+	var operators []string
+
+	for _, v := range tfSet.List() {
+		operators = append(operators, v.(string))
+	}
+
+	sort.Strings(operators)
+
+	return operators
+}
+
+// expandManagementPolicyStringValue expands a setting block built with
+// managementPolicyStringValueSchema into the document at key.
+func expandManagementPolicyStringValue(doc map[string]interface{}, key string, tfList []interface{}) {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	setting := map[string]interface{}{
+		managementPolicyOperatorAssign: tfMap["value"].(string),
+	}
+
+	if v, ok := tfMap["operators_allowed_for_child_policies"].(*schema.Set); ok && v.Len() > 0 {
+		setting[managementPolicyOperatorsAllowedForChildPolicies] = expandManagementPolicyChildPolicyOperators(v)
+	}
+
+	doc[key] = setting
+}
+
+// expandManagementPolicyListValue expands a setting block built with
+// managementPolicyListValueSchema into the document at key.
+func expandManagementPolicyListValue(doc map[string]interface{}, key string, tfList []interface{}) {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	values := []string{}
+
+	for _, v := range tfMap["values"].([]interface{}) {
+		values = append(values, v.(string))
+	}
+
+	setting := map[string]interface{}{
+		tfMap["operator"].(string): values,
+	}
+
+	if v, ok := tfMap["operators_allowed_for_child_policies"].(*schema.Set); ok && v.Len() > 0 {
+		setting[managementPolicyOperatorsAllowedForChildPolicies] = expandManagementPolicyChildPolicyOperators(v)
+	}
+
+	doc[key] = setting
+}
+
+// expandManagementPolicyNode returns a new named node in the document,
+// including any operators allowed for child policies at that level.
+func expandManagementPolicyNode(doc map[string]interface{}, tfMap map[string]interface{}) map[string]interface{} {
+	node := map[string]interface{}{}
+
+	if v, ok := tfMap["operators_allowed_for_child_policies"].(*schema.Set); ok && v.Len() > 0 {
+		node[managementPolicyOperatorsAllowedForChildPolicies] = expandManagementPolicyChildPolicyOperators(v)
+	}
+
+	doc[tfMap["name"].(string)] = node
+
+	return node
+}
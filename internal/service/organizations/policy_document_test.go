@@ -0,0 +1,121 @@
+package organizations_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	tforganizations "github.com/nij4t/terraform-provider-aws/internal/service/organizations"
+)
+
+func TestMergeManagementPolicyDocuments(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Dst      string
+		Src      string
+		Expected string
+	}{
+		{
+			Name:     "disjoint",
+			Dst:      `{"tags":{"costcenter":{"tag_key":{"@@assign":"CostCenter"}}}}`,
+			Src:      `{"tags":{"project":{"tag_key":{"@@assign":"Project"}}}}`,
+			Expected: `{"tags":{"costcenter":{"tag_key":{"@@assign":"CostCenter"}},"project":{"tag_key":{"@@assign":"Project"}}}}`,
+		},
+		{
+			Name:     "deep merge",
+			Dst:      `{"tags":{"costcenter":{"tag_key":{"@@assign":"CostCenter"}}}}`,
+			Src:      `{"tags":{"costcenter":{"tag_value":{"@@assign":["100"]}}}}`,
+			Expected: `{"tags":{"costcenter":{"tag_key":{"@@assign":"CostCenter"},"tag_value":{"@@assign":["100"]}}}}`,
+		},
+		{
+			Name:     "value operator replaced",
+			Dst:      `{"tags":{"costcenter":{"tag_value":{"@@assign":["100"],"@@operators_allowed_for_child_policies":["@@none"]}}}}`,
+			Src:      `{"tags":{"costcenter":{"tag_value":{"@@append":["200"]}}}}`,
+			Expected: `{"tags":{"costcenter":{"tag_value":{"@@append":["200"],"@@operators_allowed_for_child_policies":["@@none"]}}}}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var dst, src map[string]interface{}
+
+			if err := json.Unmarshal([]byte(testCase.Dst), &dst); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := json.Unmarshal([]byte(testCase.Src), &src); err != nil {
+				t.Fatal(err)
+			}
+
+			tforganizations.MergeManagementPolicyDocuments(dst, src)
+
+			got, err := json.Marshal(dst)
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if string(got) != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestManagementPoliciesAreEquivalent(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Policy1       string
+		Policy2       string
+		Equivalent    bool
+		ExpectedError bool
+	}{
+		{
+			Name:       "formatting",
+			Policy1:    `{"services":{"default":{"opt_out_policy":{"@@assign":"optOut"}}}}`,
+			Policy2:    "{\n  \"services\": {\n    \"default\": {\n      \"opt_out_policy\": {\n        \"@@assign\": \"optOut\"\n      }\n    }\n  }\n}",
+			Equivalent: true,
+		},
+		{
+			Name:       "operator value order",
+			Policy1:    `{"tags":{"costcenter":{"tag_value":{"@@assign":["100","200"]}}}}`,
+			Policy2:    `{"tags":{"costcenter":{"tag_value":{"@@assign":["200","100"]}}}}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "different value",
+			Policy1:    `{"services":{"default":{"opt_out_policy":{"@@assign":"optOut"}}}}`,
+			Policy2:    `{"services":{"default":{"opt_out_policy":{"@@assign":"optIn"}}}}`,
+			Equivalent: false,
+		},
+		{
+			Name:       "different operator",
+			Policy1:    `{"tags":{"costcenter":{"tag_value":{"@@assign":["100"]}}}}`,
+			Policy2:    `{"tags":{"costcenter":{"tag_value":{"@@append":["100"]}}}}`,
+			Equivalent: false,
+		},
+		{
+			Name:          "invalid JSON",
+			Policy1:       `{`,
+			Policy2:       `{}`,
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := tforganizations.ManagementPoliciesAreEquivalent(testCase.Policy1, testCase.Policy2)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.Equivalent {
+				t.Errorf("got %t, expected %t", got, testCase.Equivalent)
+			}
+		})
+	}
+}
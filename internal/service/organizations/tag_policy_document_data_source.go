@@ -0,0 +1,59 @@
+package organizations
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceTagPolicyDocument() *schema.Resource {
+	childOperators := managementPolicyChildPolicyOperators()
+	valueOperators := managementPolicyValueOperators()
+
+	return &schema.Resource{
+		Read: dataSourceTagPolicyDocumentRead,
+
+		Schema: managementPolicyDocumentSchema(map[string]*schema.Schema{
+			"tag": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enforced_for": managementPolicyListValueSchema(valueOperators, childOperators),
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"operators_allowed_for_child_policies": managementPolicyChildPolicyOperatorsSchema(childOperators),
+						"tag_key":                              managementPolicyStringValueSchema(childOperators, nil),
+						"tag_value":                            managementPolicyListValueSchema(valueOperators, childOperators),
+					},
+				},
+			},
+		}),
+	}
+}
+
+func dataSourceTagPolicyDocumentRead(d *schema.ResourceData, meta interface{}) error {
+	doc := map[string]interface{}{}
+
+	if v, ok := d.GetOk("tag"); ok && len(v.([]interface{})) > 0 {
+		tags := map[string]interface{}{}
+
+		for _, tfMapRaw := range v.([]interface{}) {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			tag := expandManagementPolicyNode(tags, tfMap)
+
+			expandManagementPolicyStringValue(tag, "tag_key", tfMap["tag_key"].([]interface{}))
+			expandManagementPolicyListValue(tag, "tag_value", tfMap["tag_value"].([]interface{}))
+			expandManagementPolicyListValue(tag, "enforced_for", tfMap["enforced_for"].([]interface{}))
+		}
+
+		doc["tags"] = tags
+	}
+
+	return setManagementPolicyDocument(d, doc)
+}
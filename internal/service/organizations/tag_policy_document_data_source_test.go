@@ -0,0 +1,170 @@
+package organizations_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/nij4t/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccOrganizationsTagPolicyDocumentDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_organizations_tag_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, organizations.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagPolicyDocumentDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "json", testAccTagPolicyDocumentDataSourceExpectedJSON_basic),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOrganizationsTagPolicyDocumentDataSource_sourceOverride(t *testing.T) {
+	dataSourceName := "data.aws_organizations_tag_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, organizations.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagPolicyDocumentDataSourceConfig_sourceOverride,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "json", testAccTagPolicyDocumentDataSourceExpectedJSON_sourceOverride),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOrganizationsTagPolicyDocumentDataSource_invalidOperator(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, organizations.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTagPolicyDocumentDataSourceConfig_invalidOperator,
+				ExpectError: regexp.MustCompile(`expected tag.0.tag_value.0.operator to be one of`),
+			},
+		},
+	})
+}
+
+const testAccTagPolicyDocumentDataSourceConfig_basic = `
+data "aws_organizations_tag_policy_document" "test" {
+  tag {
+    name = "costcenter"
+
+    tag_key {
+      value                                = "CostCenter"
+      operators_allowed_for_child_policies = ["@@none"]
+    }
+
+    tag_value {
+      values = ["100", "200"]
+    }
+
+    enforced_for {
+      operator = "@@append"
+      values   = ["ec2:instance"]
+    }
+  }
+}
+`
+
+const testAccTagPolicyDocumentDataSourceExpectedJSON_basic = `{
+  "tags": {
+    "costcenter": {
+      "enforced_for": {
+        "@@append": ["ec2:instance"]
+      },
+      "tag_key": {
+        "@@assign": "CostCenter",
+        "@@operators_allowed_for_child_policies": ["@@none"]
+      },
+      "tag_value": {
+        "@@assign": ["100", "200"]
+      }
+    }
+  }
+}`
+
+const testAccTagPolicyDocumentDataSourceConfig_sourceOverride = `
+data "aws_organizations_tag_policy_document" "source" {
+  tag {
+    name = "project"
+
+    tag_key {
+      value = "Project"
+    }
+  }
+}
+
+data "aws_organizations_tag_policy_document" "override" {
+  tag {
+    name = "costcenter"
+
+    tag_value {
+      operator = "@@remove"
+      values   = ["200"]
+    }
+  }
+}
+
+data "aws_organizations_tag_policy_document" "test" {
+  source_policy_documents   = [data.aws_organizations_tag_policy_document.source.json]
+  override_policy_documents = [data.aws_organizations_tag_policy_document.override.json]
+
+  tag {
+    name = "costcenter"
+
+    tag_key {
+      value = "CostCenter"
+    }
+
+    tag_value {
+      values = ["100", "200"]
+    }
+  }
+}
+`
+
+const testAccTagPolicyDocumentDataSourceExpectedJSON_sourceOverride = `{
+  "tags": {
+    "costcenter": {
+      "tag_key": {
+        "@@assign": "CostCenter"
+      },
+      "tag_value": {
+        "@@remove": ["200"]
+      }
+    },
+    "project": {
+      "tag_key": {
+        "@@assign": "Project"
+      }
+    }
+  }
+}`
+
+const testAccTagPolicyDocumentDataSourceConfig_invalidOperator = `
+data "aws_organizations_tag_policy_document" "test" {
+  tag {
+    name = "costcenter"
+
+    tag_value {
+      operator = "@@replace"
+      values   = ["100"]
+    }
+  }
+}
+`
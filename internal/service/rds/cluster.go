@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/nij4t/terraform-provider-aws/internal/conns"
+	"github.com/nij4t/terraform-provider-aws/internal/create"
 	"github.com/nij4t/terraform-provider-aws/internal/flex"
 	tfiam "github.com/nij4t/terraform-provider-aws/internal/service/iam"
 	tftags "github.com/nij4t/terraform-provider-aws/internal/tags"
@@ -512,9 +513,17 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 	if v, ok := d.GetOk("cluster_identifier"); ok {
 		identifier = v.(string)
 	} else if v, ok := d.GetOk("cluster_identifier_prefix"); ok {
-		identifier = resource.PrefixedUniqueId(v.(string))
+		identifier = create.Name(meta.(*conns.AWSClient).NamingPolicy, "", v.(string))
 	} else {
-		identifier = resource.PrefixedUniqueId("tf-")
+		// Generated identifiers start with "tf-", unless replaced by the provider naming prefix.
+		policy := meta.(*conns.AWSClient).NamingPolicy
+		namePrefix := "tf-"
+
+		if policy != nil && policy.Prefix != "" {
+			namePrefix = ""
+		}
+
+		identifier = create.Name(policy, "", namePrefix)
 	}
 
 	if _, ok := d.GetOk("snapshot_identifier"); ok {
@@ -0,0 +1,175 @@
+package rds
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nij4t/terraform-provider-aws/internal/conns"
+	"github.com/nij4t/terraform-provider-aws/internal/tfresource"
+	"github.com/nij4t/terraform-provider-aws/internal/verify"
+)
+
+const (
+	clusterActivityStreamStartedTimeout = 30 * time.Minute
+	clusterActivityStreamStoppedTimeout = 30 * time.Minute
+)
+
+func ResourceClusterActivityStream() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceClusterActivityStreamCreate,
+		Read:   resourceClusterActivityStreamRead,
+		Delete: resourceClusterActivityStreamDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceClusterActivityStreamImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(clusterActivityStreamStartedTimeout),
+			Delete: schema.DefaultTimeout(clusterActivityStreamStoppedTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"apply_immediately": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+			"engine_native_audit_fields_included": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"kinesis_stream_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"mode": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(rds.ActivityStreamMode_Values(), false),
+			},
+			"resource_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceClusterActivityStreamCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	arn := d.Get("resource_arn").(string)
+	applyImmediately := d.Get("apply_immediately").(bool)
+	input := &rds.StartActivityStreamInput{
+		ApplyImmediately:                aws.Bool(applyImmediately),
+		EngineNativeAuditFieldsIncluded: aws.Bool(d.Get("engine_native_audit_fields_included").(bool)),
+		KmsKeyId:                        aws.String(d.Get("kms_key_id").(string)),
+		Mode:                            aws.String(d.Get("mode").(string)),
+		ResourceArn:                     aws.String(arn),
+	}
+
+	log.Printf("[DEBUG] Starting RDS Cluster Activity Stream: %s", input)
+	_, err := conn.StartActivityStream(input)
+
+	if err != nil {
+		return fmt.Errorf("error starting RDS Cluster (%s) Activity Stream: %w", arn, err)
+	}
+
+	d.SetId(arn)
+
+	// Without apply_immediately the stream only starts in the next maintenance window.
+	if applyImmediately {
+		if _, err := waitActivityStreamStarted(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return fmt.Errorf("error waiting for RDS Cluster (%s) Activity Stream to start: %w", d.Id(), err)
+		}
+	}
+
+	return resourceClusterActivityStreamRead(d, meta)
+}
+
+func resourceClusterActivityStreamRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	dbCluster, err := FindDBClusterByARN(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] RDS Cluster (%s) not found, removing Activity Stream from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading RDS Cluster (%s): %w", d.Id(), err)
+	}
+
+	if status := aws.StringValue(dbCluster.ActivityStreamStatus); status == rds.ActivityStreamStatusStopped && d.Get("apply_immediately").(bool) {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading RDS Cluster (%s) Activity Stream: %s after creation", d.Id(), status)
+		}
+
+		log.Printf("[WARN] RDS Cluster (%s) Activity Stream is %s, removing from state", d.Id(), status)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("kinesis_stream_name", dbCluster.ActivityStreamKinesisStreamName)
+	d.Set("resource_arn", dbCluster.DBClusterArn)
+
+	// Until the stream has started the cluster does not report its settings.
+	if dbCluster.ActivityStreamMode != nil {
+		d.Set("kms_key_id", dbCluster.ActivityStreamKmsKeyId)
+		d.Set("mode", dbCluster.ActivityStreamMode)
+	}
+
+	return nil
+}
+
+func resourceClusterActivityStreamDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	log.Printf("[DEBUG] Stopping RDS Cluster Activity Stream: %s", d.Id())
+	_, err := conn.StopActivityStream(&rds.StopActivityStreamInput{
+		ApplyImmediately: aws.Bool(true),
+		ResourceArn:      aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBClusterNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error stopping RDS Cluster (%s) Activity Stream: %w", d.Id(), err)
+	}
+
+	if _, err := waitActivityStreamStopped(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for RDS Cluster (%s) Activity Stream to stop: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceClusterActivityStreamImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// Neither apply_immediately nor engine_native_audit_fields_included can be read back
+	// from the cluster, so fall back to their defaults.
+	d.Set("apply_immediately", true)
+	d.Set("engine_native_audit_fields_included", false)
+	return []*schema.ResourceData{d}, nil
+}
@@ -19,6 +19,7 @@ import (
 
 const (
 	AWSRDSClusterEndpointCreateTimeout = 30 * time.Minute
+	AWSRDSClusterEndpointDeleteTimeout = 20 * time.Minute
 	AWSRDSClusterEndpointRetryDelay    = 5 * time.Second
 	ClusterEndpointRetryMinTimeout     = 3 * time.Second
 )
@@ -33,6 +34,11 @@ func ResourceClusterEndpoint() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(AWSRDSClusterEndpointCreateTimeout),
+			Delete: schema.DefaultTimeout(AWSRDSClusterEndpointDeleteTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -114,7 +120,7 @@ func resourceClusterEndpointCreate(d *schema.ResourceData, meta interface{}) err
 
 	d.SetId(endpointId)
 
-	err = resourceClusterEndpointWaitForAvailable(AWSRDSClusterEndpointCreateTimeout, d.Id(), conn)
+	err = resourceClusterEndpointWaitForAvailable(d.Timeout(schema.TimeoutCreate), d.Id(), conn)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -26,7 +27,7 @@ func ResourceClusterInstance() *schema.Resource {
 		Update: resourceClusterInstanceUpdate,
 		Delete: resourceClusterInstanceDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceClusterInstanceImport,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -121,6 +122,29 @@ func ResourceClusterInstance() *schema.Resource {
 				Computed: true,
 			},
 
+			"applied_parameters": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"parameter_apply_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"pending_reboot_parameters": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"reboot_on_pending_parameters": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			// apply_immediately is used to determine when the update modifications
 			// take place.
 			// See http://docs.aws.amazon.com/AmazonRDS/latest/UserGuide/Overview.DBInstance.Modifying.html
@@ -232,7 +256,10 @@ func ResourceClusterInstance() *schema.Resource {
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			customizeDiffRebootOnPendingParameters,
+		),
 	}
 }
 
@@ -405,6 +432,12 @@ func resourceClusterInstanceCreate(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
+	if d.Get("reboot_on_pending_parameters").(bool) {
+		if err := rebootDBInstanceIfParametersPendingReboot(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
 	return resourceClusterInstanceRead(d, meta)
 }
 
@@ -484,6 +517,10 @@ func resourceClusterInstanceRead(d *schema.ResourceData, meta interface{}) error
 		d.Set("db_parameter_group_name", db.DBParameterGroups[0].DBParameterGroupName)
 	}
 
+	if err := setDBInstanceParameterApplyStatus(d, conn, db, dbc); err != nil {
+		return err
+	}
+
 	tags, err := ListTags(conn, aws.StringValue(db.DBInstanceArn))
 	if err != nil {
 		return fmt.Errorf("error listing tags for RDS Cluster Instance (%s): %w", d.Id(), err)
@@ -627,6 +664,12 @@ func resourceClusterInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
+	if d.Get("reboot_on_pending_parameters").(bool) {
+		if err := rebootDBInstanceIfParametersPendingReboot(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
 	return resourceClusterInstanceRead(d, meta)
 }
 
@@ -683,6 +726,12 @@ var resourceClusterInstanceCreateUpdatePendingStates = []string{
 	"upgrading",
 }
 
+func resourceClusterInstanceImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// reboot_on_pending_parameters cannot be read from the API.
+	d.Set("reboot_on_pending_parameters", false)
+	return []*schema.ResourceData{d}, nil
+}
+
 func rdsClusterSetResourceDataEngineVersionFromClusterInstance(d *schema.ResourceData, c *rds.DBInstance) {
 	oldVersion := d.Get("engine_version").(string)
 	newVersion := aws.StringValue(c.EngineVersion)
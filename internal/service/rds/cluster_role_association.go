@@ -23,6 +23,11 @@ func ResourceClusterRoleAssociation() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(dbClusterRoleAssociationCreatedTimeout),
+			Delete: schema.DefaultTimeout(dbClusterRoleAssociationDeletedTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"db_cluster_identifier": {
 				Type:     schema.TypeString,
@@ -64,7 +69,7 @@ func resourceClusterRoleAssociationCreate(d *schema.ResourceData, meta interface
 
 	d.SetId(ClusterRoleAssociationCreateResourceID(dbClusterID, roleARN))
 
-	_, err = waitDBClusterRoleAssociationCreated(conn, dbClusterID, roleARN)
+	_, err = waitDBClusterRoleAssociationCreated(conn, dbClusterID, roleARN, d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
 		return fmt.Errorf("error waiting for RDS DB Cluster (%s) IAM Role (%s) Association to create: %w", dbClusterID, roleARN, err)
@@ -127,7 +132,7 @@ func resourceClusterRoleAssociationDelete(d *schema.ResourceData, meta interface
 		return fmt.Errorf("error deleting RDS DB Cluster (%s) IAM Role (%s) Association: %w", dbClusterID, roleARN, err)
 	}
 
-	_, err = waitDBClusterRoleAssociationDeleted(conn, dbClusterID, roleARN)
+	_, err = waitDBClusterRoleAssociationDeleted(conn, dbClusterID, roleARN, d.Timeout(schema.TimeoutDelete))
 
 	if err != nil {
 		return fmt.Errorf("error waiting for RDS DB Cluster (%s) IAM Role (%s) Association to delete: %w", dbClusterID, roleARN, err)
@@ -58,3 +58,27 @@ func ExportableLogType_Values() []string {
 		ExportableLogTypeUpgrade,
 	}
 }
+
+const (
+	InstanceAutomatedBackupStatusPending     = "pending"
+	InstanceAutomatedBackupStatusReplicating = "replicating"
+	InstanceAutomatedBackupStatusRetained    = "retained"
+)
+
+const (
+	ExportTaskStatusCanceled   = "CANCELED"
+	ExportTaskStatusCanceling  = "CANCELING"
+	ExportTaskStatusComplete   = "COMPLETE"
+	ExportTaskStatusFailed     = "FAILED"
+	ExportTaskStatusInProgress = "IN_PROGRESS"
+	ExportTaskStatusStarting   = "STARTING"
+)
+
+const (
+	InstanceParameterApplyStatusPendingReboot = "pending-reboot"
+)
+
+const (
+	ParameterApplyTypeDynamic = "dynamic"
+	ParameterApplyTypeStatic  = "static"
+)
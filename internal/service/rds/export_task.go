@@ -0,0 +1,252 @@
+package rds
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nij4t/terraform-provider-aws/internal/conns"
+	"github.com/nij4t/terraform-provider-aws/internal/flex"
+	"github.com/nij4t/terraform-provider-aws/internal/tfresource"
+	"github.com/nij4t/terraform-provider-aws/internal/verify"
+)
+
+const (
+	exportTaskCreateTimeout = 60 * time.Minute
+	exportTaskDeleteTimeout = 20 * time.Minute
+)
+
+func ResourceExportTask() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceExportTaskCreate,
+		ReadContext:   resourceExportTaskRead,
+		DeleteContext: resourceExportTaskDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(exportTaskCreateTimeout),
+			Delete: schema.DefaultTimeout(exportTaskDeleteTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"export_only": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"export_task_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"failure_cause": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"iam_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"percent_progress": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"s3_bucket_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"s3_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"snapshot_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"task_end_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"task_start_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"total_extracted_data_in_gb": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"warning_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceExportTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	id := d.Get("export_task_identifier").(string)
+	input := &rds.StartExportTaskInput{
+		ExportTaskIdentifier: aws.String(id),
+		IamRoleArn:           aws.String(d.Get("iam_role_arn").(string)),
+		KmsKeyId:             aws.String(d.Get("kms_key_id").(string)),
+		S3BucketName:         aws.String(d.Get("s3_bucket_name").(string)),
+		SourceArn:            aws.String(d.Get("source_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("export_only"); ok && len(v.([]interface{})) > 0 {
+		input.ExportOnly = flex.ExpandStringList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("s3_prefix"); ok {
+		input.S3Prefix = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Starting RDS Export Task: %s", input)
+	_, err := conn.StartExportTaskWithContext(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error starting RDS Export Task (%s): %w", id, err))
+	}
+
+	d.SetId(id)
+
+	var diags diag.Diagnostics
+
+	// Export task identifiers can't be reused, so a failed export is kept in state rather than
+	// tainted: replacing it with the same identifier would fail. Exports still running are tainted.
+	if task, err := waitExportTaskCompleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		if task == nil || (aws.StringValue(task.Status) != ExportTaskStatusFailed && aws.StringValue(task.Status) != ExportTaskStatusCanceled) {
+			return diag.FromErr(fmt.Errorf("error waiting for RDS Export Task (%s) to complete: %w", d.Id(), err))
+		}
+
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("RDS Export Task (%s) did not complete", d.Id()),
+			Detail:   fmt.Sprintf("%s\n\nTo run the export again, change export_task_identifier.", err),
+		})
+	}
+
+	return append(diags, resourceExportTaskRead(ctx, d, meta)...)
+}
+
+func resourceExportTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	task, err := FindExportTaskByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] RDS Export Task (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading RDS Export Task (%s): %w", d.Id(), err))
+	}
+
+	d.Set("export_only", aws.StringValueSlice(task.ExportOnly))
+	d.Set("export_task_identifier", task.ExportTaskIdentifier)
+	d.Set("failure_cause", task.FailureCause)
+	d.Set("iam_role_arn", task.IamRoleArn)
+	// The API returns the key ARN; keep a configured key ID or alias to avoid a replacement.
+	if d.Get("kms_key_id").(string) == "" {
+		d.Set("kms_key_id", task.KmsKeyId)
+	}
+	d.Set("percent_progress", task.PercentProgress)
+	d.Set("s3_bucket_name", task.S3Bucket)
+	d.Set("s3_prefix", task.S3Prefix)
+	if task.SnapshotTime != nil {
+		d.Set("snapshot_time", aws.TimeValue(task.SnapshotTime).Format(time.RFC3339))
+	} else {
+		d.Set("snapshot_time", nil)
+	}
+	d.Set("source_arn", task.SourceArn)
+	d.Set("status", task.Status)
+	if task.TaskEndTime != nil {
+		d.Set("task_end_time", aws.TimeValue(task.TaskEndTime).Format(time.RFC3339))
+	} else {
+		d.Set("task_end_time", nil)
+	}
+	if task.TaskStartTime != nil {
+		d.Set("task_start_time", aws.TimeValue(task.TaskStartTime).Format(time.RFC3339))
+	} else {
+		d.Set("task_start_time", nil)
+	}
+	d.Set("total_extracted_data_in_gb", task.TotalExtractedDataInGB)
+	d.Set("warning_message", task.WarningMessage)
+
+	return nil
+}
+
+func resourceExportTaskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	task, err := FindExportTaskByID(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading RDS Export Task (%s): %w", d.Id(), err))
+	}
+
+	// Completed, failed and canceled exports cannot be removed; only running ones are canceled.
+	switch aws.StringValue(task.Status) {
+	case ExportTaskStatusCanceled, ExportTaskStatusComplete, ExportTaskStatusFailed:
+		log.Printf("[DEBUG] RDS Export Task (%s) is %s, removing from state only", d.Id(), aws.StringValue(task.Status))
+		return nil
+	}
+
+	log.Printf("[DEBUG] Canceling RDS Export Task: %s", d.Id())
+	_, err = conn.CancelExportTaskWithContext(ctx, &rds.CancelExportTaskInput{
+		ExportTaskIdentifier: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeExportTaskNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error canceling RDS Export Task (%s): %w", d.Id(), err))
+	}
+
+	if _, err := waitExportTaskCanceled(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.FromErr(fmt.Errorf("error waiting for RDS Export Task (%s) to cancel: %w", d.Id(), err))
+	}
+
+	return nil
+}
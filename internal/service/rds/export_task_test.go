@@ -0,0 +1,205 @@
+package rds_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/rds"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/nij4t/terraform-provider-aws/internal/acctest"
+	"github.com/nij4t/terraform-provider-aws/internal/conns"
+	tfrds "github.com/nij4t/terraform-provider-aws/internal/service/rds"
+)
+
+func TestAccRDSExportTask_basic(t *testing.T) {
+	var exportTask rds.ExportTask
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_export_task.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, rds.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExportTaskConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExportTaskExists(resourceName, &exportTask),
+					resource.TestCheckResourceAttr(resourceName, "export_task_identifier", rName),
+					resource.TestCheckResourceAttr(resourceName, "id", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "source_arn", "aws_db_snapshot.test", "db_snapshot_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "s3_bucket_name", "aws_s3_bucket.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "iam_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "kms_key_id", "aws_kms_key.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "status", tfrds.ExportTaskStatusComplete),
+					resource.TestCheckResourceAttr(resourceName, "percent_progress", "100"),
+					resource.TestCheckResourceAttrSet(resourceName, "task_start_time"),
+					resource.TestCheckResourceAttrSet(resourceName, "task_end_time"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRDSExportTask_optional(t *testing.T) {
+	var exportTask rds.ExportTask
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_export_task.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, rds.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExportTaskOptionalConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExportTaskExists(resourceName, &exportTask),
+					resource.TestCheckResourceAttr(resourceName, "s3_prefix", "exports"),
+					resource.TestCheckResourceAttr(resourceName, "export_only.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "export_only.0", "mydb"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckExportTaskExists(n string, v *rds.ExportTask) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No RDS Export Task ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn
+
+		output, err := tfrds.FindExportTaskByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccExportTaskBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "export.rds.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Action   = ["s3:ListAllMyBuckets"]
+        Effect   = "Allow"
+        Resource = "*"
+      },
+      {
+        Action   = ["s3:GetBucketLocation", "s3:ListBucket"]
+        Effect   = "Allow"
+        Resource = aws_s3_bucket.test.arn
+      },
+      {
+        Action   = ["s3:GetObject", "s3:PutObject", "s3:DeleteObject"]
+        Effect   = "Allow"
+        Resource = "${aws_s3_bucket.test.arn}/*"
+      },
+    ]
+  })
+}
+
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_db_instance" "test" {
+  allocated_storage       = 10
+  engine                  = "mysql"
+  engine_version          = "5.7"
+  instance_class          = "db.t3.micro"
+  name                    = "mydb"
+  identifier              = %[1]q
+  password                = "barbarbarbar"
+  username                = "foo"
+  backup_retention_period = 0
+  skip_final_snapshot     = true
+}
+
+resource "aws_db_snapshot" "test" {
+  db_instance_identifier = aws_db_instance.test.id
+  db_snapshot_identifier = %[1]q
+}
+`, rName)
+}
+
+func testAccExportTaskConfig(rName string) string {
+	return acctest.ConfigCompose(testAccExportTaskBaseConfig(rName), fmt.Sprintf(`
+resource "aws_rds_export_task" "test" {
+  export_task_identifier = %[1]q
+  source_arn             = aws_db_snapshot.test.db_snapshot_arn
+  s3_bucket_name         = aws_s3_bucket.test.id
+  iam_role_arn           = aws_iam_role.test.arn
+  kms_key_id             = aws_kms_key.test.arn
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
+
+func testAccExportTaskOptionalConfig(rName string) string {
+	return acctest.ConfigCompose(testAccExportTaskBaseConfig(rName), fmt.Sprintf(`
+resource "aws_rds_export_task" "test" {
+  export_task_identifier = %[1]q
+  source_arn             = aws_db_snapshot.test.db_snapshot_arn
+  s3_bucket_name         = aws_s3_bucket.test.id
+  s3_prefix              = "exports"
+  iam_role_arn           = aws_iam_role.test.arn
+  kms_key_id             = aws_kms_key.test.arn
+  export_only            = ["mydb"]
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
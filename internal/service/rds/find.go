@@ -1,6 +1,7 @@
 package rds
 
 import (
+	"context"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
@@ -189,3 +190,182 @@ func FindEventSubscriptionByID(conn *rds.RDS, id string) (*rds.EventSubscription
 
 	return output.EventSubscriptionsList[0], nil
 }
+
+func FindDBInstanceAutomatedBackupByARN(conn *rds.RDS, arn string) (*rds.DBInstanceAutomatedBackup, error) {
+	input := &rds.DescribeDBInstanceAutomatedBackupsInput{
+		DBInstanceAutomatedBackupsArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeDBInstanceAutomatedBackups(input)
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBInstanceAutomatedBackupNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.DBInstanceAutomatedBackups) == 0 || output.DBInstanceAutomatedBackups[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	backup := output.DBInstanceAutomatedBackups[0]
+
+	// Eventual consistency check.
+	if aws.StringValue(backup.DBInstanceAutomatedBackupsArn) != arn {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return backup, nil
+}
+
+func FindDBInstanceAutomatedBackupsReplication(conn *rds.RDS, dbInstanceID, backupARN string) (*rds.DBInstanceAutomatedBackupsReplication, error) {
+	dbInstance, err := FindDBInstanceByID(conn, dbInstanceID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range dbInstance.DBInstanceAutomatedBackupsReplications {
+		if aws.StringValue(v.DBInstanceAutomatedBackupsArn) == backupARN {
+			return v, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{}
+}
+
+func FindExportTaskByID(ctx context.Context, conn *rds.RDS, id string) (*rds.ExportTask, error) {
+	input := &rds.DescribeExportTasksInput{
+		ExportTaskIdentifier: aws.String(id),
+	}
+
+	output, err := conn.DescribeExportTasksWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeExportTaskNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.ExportTasks) == 0 || output.ExportTasks[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ExportTasks[0], nil
+}
+
+func FindDBClusterByARN(conn *rds.RDS, arn string) (*rds.DBCluster, error) {
+	input := &rds.DescribeDBClustersInput{
+		DBClusterIdentifier: aws.String(arn),
+	}
+
+	output, err := conn.DescribeDBClusters(input)
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBClusterNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.DBClusters) == 0 || output.DBClusters[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	dbCluster := output.DBClusters[0]
+
+	// Eventual consistency check.
+	if aws.StringValue(dbCluster.DBClusterArn) != arn {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return dbCluster, nil
+}
+
+func FindDBParametersByGroupNameAndSource(conn *rds.RDS, groupName, source string) ([]*rds.Parameter, error) {
+	input := &rds.DescribeDBParametersInput{
+		DBParameterGroupName: aws.String(groupName),
+		Source:               aws.String(source),
+	}
+	var output []*rds.Parameter
+
+	err := conn.DescribeDBParametersPages(input, func(page *rds.DescribeDBParametersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Parameters {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBParameterGroupNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindDBClusterParametersByGroupNameAndSource(conn *rds.RDS, groupName, source string) ([]*rds.Parameter, error) {
+	input := &rds.DescribeDBClusterParametersInput{
+		DBClusterParameterGroupName: aws.String(groupName),
+		Source:                      aws.String(source),
+	}
+	var output []*rds.Parameter
+
+	err := conn.DescribeDBClusterParametersPages(input, func(page *rds.DescribeDBClusterParametersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Parameters {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBParameterGroupNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
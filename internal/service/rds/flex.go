@@ -255,3 +255,24 @@ func FlattenParameters(list []*rds.Parameter) []map[string]interface{} {
 
 	return result
 }
+
+// flattenParameterNamesByApplyType returns the names of the static parameters, which are applied when
+// the DB instance is rebooted, and of the dynamic parameters, which are applied without a reboot.
+func flattenParameterNamesByApplyType(list []*rds.Parameter) ([]string, []string) {
+	var static, dynamic []string
+
+	for _, v := range list {
+		if v == nil {
+			continue
+		}
+
+		switch name := aws.StringValue(v.ParameterName); aws.StringValue(v.ApplyType) {
+		case ParameterApplyTypeStatic:
+			static = append(static, name)
+		case ParameterApplyTypeDynamic:
+			dynamic = append(dynamic, name)
+		}
+	}
+
+	return static, dynamic
+}
@@ -32,6 +32,37 @@ func TestExpandParameters(t *testing.T) {
 	}
 }
 
+func TestFlattenParameterNamesByApplyType(t *testing.T) {
+	parameters := []*rds.Parameter{
+		{
+			ApplyMethod:   aws.String(rds.ApplyMethodPendingReboot),
+			ApplyType:     aws.String(ParameterApplyTypeStatic),
+			ParameterName: aws.String("performance_schema"),
+		},
+		{
+			ApplyMethod:   aws.String(rds.ApplyMethodImmediate),
+			ApplyType:     aws.String(ParameterApplyTypeDynamic),
+			ParameterName: aws.String("character_set_server"),
+		},
+		{
+			ApplyMethod:   aws.String(rds.ApplyMethodPendingReboot),
+			ApplyType:     aws.String(ParameterApplyTypeDynamic),
+			ParameterName: aws.String("max_connections"),
+		},
+		nil,
+	}
+
+	static, dynamic := flattenParameterNamesByApplyType(parameters)
+
+	if expected := []string{"performance_schema"}; !reflect.DeepEqual(static, expected) {
+		t.Errorf("got static parameters %v, expected %v", static, expected)
+	}
+
+	if expected := []string{"character_set_server", "max_connections"}; !reflect.DeepEqual(dynamic, expected) {
+		t.Errorf("got dynamic parameters %v, expected %v", dynamic, expected)
+	}
+}
+
 func TestFlattenParameters(t *testing.T) {
 	cases := []struct {
 		Input  []*rds.Parameter
@@ -16,6 +16,10 @@ import (
 )
 
 const (
+	GlobalClusterCreateTimeout = 10 * time.Minute
+	GlobalClusterDeleteTimeout = 10 * time.Minute
+	GlobalClusterUpdateTimeout = 10 * time.Minute
+
 	rdsGlobalClusterRemovalTimeout = 2 * time.Minute
 )
 
@@ -29,6 +33,12 @@ func ResourceGlobalCluster() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(GlobalClusterCreateTimeout),
+			Update: schema.DefaultTimeout(GlobalClusterUpdateTimeout),
+			Delete: schema.DefaultTimeout(GlobalClusterDeleteTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -153,7 +163,7 @@ func resourceGlobalClusterCreate(d *schema.ResourceData, meta interface{}) error
 
 	d.SetId(aws.StringValue(output.GlobalCluster.GlobalClusterIdentifier))
 
-	if err := waitForGlobalClusterCreation(conn, d.Id()); err != nil {
+	if err := waitForGlobalClusterCreation(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
 		return fmt.Errorf("error waiting for RDS Global Cluster (%s) availability: %s", d.Id(), err)
 	}
 
@@ -229,7 +239,7 @@ func resourceGlobalClusterUpdate(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("error deleting RDS Global Cluster: %s", err)
 	}
 
-	if err := waitForGlobalClusterUpdate(conn, d.Id()); err != nil {
+	if err := waitForGlobalClusterUpdate(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
 		return fmt.Errorf("error waiting for RDS Global Cluster (%s) update: %s", d.Id(), err)
 	}
 
@@ -321,7 +331,7 @@ func resourceGlobalClusterDelete(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("error deleting RDS Global Cluster: %s", err)
 	}
 
-	if err := WaitForGlobalClusterDeletion(conn, d.Id()); err != nil {
+	if err := WaitForGlobalClusterDeletion(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
 		return fmt.Errorf("error waiting for RDS Global Cluster (%s) deletion: %s", d.Id(), err)
 	}
 
@@ -434,12 +444,12 @@ func rdsGlobalClusterRefreshFunc(conn *rds.RDS, globalClusterID string) resource
 	}
 }
 
-func waitForGlobalClusterCreation(conn *rds.RDS, globalClusterID string) error {
+func waitForGlobalClusterCreation(conn *rds.RDS, globalClusterID string, timeout time.Duration) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"creating"},
 		Target:  []string{"available"},
 		Refresh: rdsGlobalClusterRefreshFunc(conn, globalClusterID),
-		Timeout: 10 * time.Minute,
+		Timeout: timeout,
 	}
 
 	log.Printf("[DEBUG] Waiting for RDS Global Cluster (%s) availability", globalClusterID)
@@ -448,12 +458,12 @@ func waitForGlobalClusterCreation(conn *rds.RDS, globalClusterID string) error {
 	return err
 }
 
-func waitForGlobalClusterUpdate(conn *rds.RDS, globalClusterID string) error {
+func waitForGlobalClusterUpdate(conn *rds.RDS, globalClusterID string, timeout time.Duration) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"modifying", "upgrading"},
 		Target:  []string{"available"},
 		Refresh: rdsGlobalClusterRefreshFunc(conn, globalClusterID),
-		Timeout: 10 * time.Minute,
+		Timeout: timeout,
 		Delay:   30 * time.Second,
 	}
 
@@ -463,7 +473,7 @@ func waitForGlobalClusterUpdate(conn *rds.RDS, globalClusterID string) error {
 	return err
 }
 
-func WaitForGlobalClusterDeletion(conn *rds.RDS, globalClusterID string) error {
+func WaitForGlobalClusterDeletion(conn *rds.RDS, globalClusterID string, timeout time.Duration) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{
 			"available",
@@ -471,7 +481,7 @@ func WaitForGlobalClusterDeletion(conn *rds.RDS, globalClusterID string) error {
 		},
 		Target:         []string{"deleted"},
 		Refresh:        rdsGlobalClusterRefreshFunc(conn, globalClusterID),
-		Timeout:        10 * time.Minute,
+		Timeout:        timeout,
 		NotFoundChecks: 1,
 	}
 
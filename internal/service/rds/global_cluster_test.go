@@ -489,7 +489,7 @@ func testAccCheckGlobalClusterDisappears(globalCluster *rds.GlobalCluster) resou
 			return err
 		}
 
-		return tfrds.WaitForGlobalClusterDeletion(conn, aws.StringValue(globalCluster.GlobalClusterIdentifier))
+		return tfrds.WaitForGlobalClusterDeletion(conn, aws.StringValue(globalCluster.GlobalClusterIdentifier), tfrds.GlobalClusterDeleteTimeout)
 	}
 }
 
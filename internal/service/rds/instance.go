@@ -1,6 +1,7 @@
 package rds
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -11,10 +12,12 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/nij4t/terraform-provider-aws/internal/conns"
+	"github.com/nij4t/terraform-provider-aws/internal/create"
 	"github.com/nij4t/terraform-provider-aws/internal/flex"
 	tfiam "github.com/nij4t/terraform-provider-aws/internal/service/iam"
 	tftags "github.com/nij4t/terraform-provider-aws/internal/tags"
@@ -308,6 +311,25 @@ func ResourceInstance() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"applied_parameters": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"parameter_apply_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"pending_reboot_parameters": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"reboot_on_pending_parameters": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"password": {
 				Type:      schema.TypeString,
 				Optional:  true,
@@ -486,7 +508,10 @@ func ResourceInstance() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			customizeDiffRebootOnPendingParameters,
+		),
 	}
 }
 
@@ -515,11 +540,7 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	if v, ok := d.GetOk("identifier"); ok {
 		identifier = v.(string)
 	} else {
-		if v, ok := d.GetOk("identifier_prefix"); ok {
-			identifier = resource.PrefixedUniqueId(v.(string))
-		} else {
-			identifier = resource.UniqueId()
-		}
+		identifier = create.Name(meta.(*conns.AWSClient).NamingPolicy, "", d.Get("identifier_prefix").(string))
 
 		d.Set("identifier", identifier)
 	}
@@ -1359,6 +1380,12 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.Get("reboot_on_pending_parameters").(bool) {
+		if err := rebootDBInstanceIfParametersPendingReboot(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
 	return resourceInstanceRead(d, meta)
 }
 
@@ -1419,6 +1446,10 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("parameter_group_name", v.DBParameterGroups[0].DBParameterGroupName)
 	}
 
+	if err := setDBInstanceParameterApplyStatus(d, conn, v, nil); err != nil {
+		return err
+	}
+
 	if v.Endpoint != nil {
 		d.Set("port", v.Endpoint.Port)
 		d.Set("address", v.Endpoint.Address)
@@ -1812,6 +1843,12 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	}
 
+	if d.Get("reboot_on_pending_parameters").(bool) {
+		if err := rebootDBInstanceIfParametersPendingReboot(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
 	return resourceInstanceRead(d, meta)
 }
 
@@ -1848,6 +1885,7 @@ func resourceInstanceImport(
 	// that final_snapshot_identifier is not required
 	d.Set("skip_final_snapshot", true)
 	d.Set("delete_automated_backups", true)
+	d.Set("reboot_on_pending_parameters", false)
 	return []*schema.ResourceData{d}, nil
 }
 
@@ -1946,3 +1984,186 @@ func dbSetResourceDataEngineVersionFromInstance(d *schema.ResourceData, c *rds.D
 	newVersion := aws.StringValue(c.EngineVersion)
 	compareActualEngineVersion(d, oldVersion, newVersion)
 }
+
+// setDBInstanceParameterApplyStatus sets parameter_apply_status, pending_reboot_parameters and applied_parameters.
+// dbc is the DB instance's Aurora cluster, if any.
+// reboot_on_pending_parameters only controls whether the DB instance is rebooted.
+func setDBInstanceParameterApplyStatus(d *schema.ResourceData, conn *rds.RDS, v *rds.DBInstance, dbc *rds.DBCluster) error {
+	d.Set("parameter_apply_status", dbInstanceParameterApplyStatus(v, dbc))
+
+	pending, applied, err := findDBInstanceParameterNamesByStatus(conn, v, dbc)
+
+	if tfawserr.ErrCodeContains(err, "AccessDenied") {
+		log.Printf("[WARN] Unable to read parameters of DB Instance (%s): %s", aws.StringValue(v.DBInstanceIdentifier), err)
+		d.Set("applied_parameters", nil)
+		d.Set("pending_reboot_parameters", nil)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading DB Instance (%s) parameters: %w", aws.StringValue(v.DBInstanceIdentifier), err)
+	}
+
+	if err := d.Set("applied_parameters", applied); err != nil {
+		return fmt.Errorf("error setting applied_parameters: %w", err)
+	}
+
+	if err := d.Set("pending_reboot_parameters", pending); err != nil {
+		return fmt.Errorf("error setting pending_reboot_parameters: %w", err)
+	}
+
+	return nil
+}
+
+// dbInstanceParameterApplyStatus returns pending-reboot if any DB parameter group of the DB instance,
+// or the DB cluster parameter group of its Aurora cluster, has changes pending a reboot of the instance.
+// Otherwise it returns the status of the DB instance's DB parameter group.
+func dbInstanceParameterApplyStatus(v *rds.DBInstance, dbc *rds.DBCluster) string {
+	if dbClusterMemberParameterGroupStatus(v, dbc) == InstanceParameterApplyStatusPendingReboot {
+		return InstanceParameterApplyStatusPendingReboot
+	}
+
+	var status string
+
+	for i, pg := range v.DBParameterGroups {
+		if pg == nil {
+			continue
+		}
+
+		if aws.StringValue(pg.ParameterApplyStatus) == InstanceParameterApplyStatusPendingReboot {
+			return InstanceParameterApplyStatusPendingReboot
+		}
+
+		if i == 0 {
+			status = aws.StringValue(pg.ParameterApplyStatus)
+		}
+	}
+
+	return status
+}
+
+// dbClusterMemberParameterGroupStatus returns the status of the DB cluster parameter group for the DB instance.
+func dbClusterMemberParameterGroupStatus(v *rds.DBInstance, dbc *rds.DBCluster) string {
+	if dbc == nil {
+		return ""
+	}
+
+	for _, m := range dbc.DBClusterMembers {
+		if m != nil && aws.StringValue(m.DBInstanceIdentifier) == aws.StringValue(v.DBInstanceIdentifier) {
+			return aws.StringValue(m.DBClusterParameterGroupStatus)
+		}
+	}
+
+	return ""
+}
+
+// findDBInstanceParameterNamesByStatus returns the names of the user-modified parameters of the DB instance's
+// DB parameter groups and its Aurora cluster's DB cluster parameter group, split into those pending a reboot
+// of the DB instance and those applied.
+// The API reports the status of parameter groups, not of individual parameters, so the static parameters
+// of a parameter group with changes pending reboot are pending, and all other parameters are applied.
+func findDBInstanceParameterNamesByStatus(conn *rds.RDS, v *rds.DBInstance, dbc *rds.DBCluster) ([]string, []string, error) {
+	var pending, applied []string
+
+	add := func(parameters []*rds.Parameter, status string) {
+		static, dynamic := flattenParameterNamesByApplyType(parameters)
+
+		if status == InstanceParameterApplyStatusPendingReboot {
+			pending = append(pending, static...)
+		} else {
+			applied = append(applied, static...)
+		}
+
+		applied = append(applied, dynamic...)
+	}
+
+	for _, pg := range v.DBParameterGroups {
+		if pg == nil {
+			continue
+		}
+
+		output, err := FindDBParametersByGroupNameAndSource(conn, aws.StringValue(pg.DBParameterGroupName), "user")
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		add(output, aws.StringValue(pg.ParameterApplyStatus))
+	}
+
+	if dbc != nil && dbc.DBClusterParameterGroup != nil {
+		output, err := FindDBClusterParametersByGroupNameAndSource(conn, aws.StringValue(dbc.DBClusterParameterGroup), "user")
+
+		if err != nil && !tfresource.NotFound(err) {
+			return nil, nil, err
+		}
+
+		add(output, dbClusterMemberParameterGroupStatus(v, dbc))
+	}
+
+	return pending, applied, nil
+}
+
+// customizeDiffRebootOnPendingParameters plans an update, and so a reboot, when
+// reboot_on_pending_parameters is enabled and the DB instance has parameters pending reboot.
+func customizeDiffRebootOnPendingParameters(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.Get("reboot_on_pending_parameters").(bool) {
+		return nil
+	}
+
+	if diff.Get("parameter_apply_status").(string) != InstanceParameterApplyStatusPendingReboot {
+		return nil
+	}
+
+	for _, k := range []string{"applied_parameters", "parameter_apply_status", "pending_reboot_parameters"} {
+		if err := diff.SetNewComputed(k); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// rebootDBInstanceIfParametersPendingReboot reboots the DB instance when its DB parameter group,
+// or its Aurora cluster's DB cluster parameter group, reports pending-reboot and waits for it to become available.
+func rebootDBInstanceIfParametersPendingReboot(conn *rds.RDS, id string, timeout time.Duration) error {
+	v, err := FindDBInstanceByID(conn, id)
+
+	if err != nil {
+		return fmt.Errorf("error reading DB Instance (%s): %w", id, err)
+	}
+
+	var dbc *rds.DBCluster
+
+	if dbClusterID := aws.StringValue(v.DBClusterIdentifier); dbClusterID != "" {
+		dbc, err = FindDBClusterByID(conn, dbClusterID)
+
+		if err != nil {
+			return fmt.Errorf("error reading RDS Cluster (%s): %w", dbClusterID, err)
+		}
+	}
+
+	if dbInstanceParameterApplyStatus(v, dbc) != InstanceParameterApplyStatusPendingReboot {
+		return nil
+	}
+
+	log.Printf("[INFO] DB Instance (%s) has parameters pending reboot, rebooting", id)
+	_, err = conn.RebootDBInstance(&rds.RebootDBInstanceInput{
+		DBInstanceIdentifier: aws.String(id),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error rebooting DB Instance (%s): %w", id, err)
+	}
+
+	log.Printf("[INFO] Waiting for DB Instance (%s) to be available", id)
+	if err := waitUntilDBInstanceAvailableAfterUpdate(id, conn, timeout); err != nil {
+		return fmt.Errorf("error waiting for DB Instance (%s) to be available: %w", id, err)
+	}
+
+	return nil
+}
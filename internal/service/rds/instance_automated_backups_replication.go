@@ -0,0 +1,179 @@
+package rds
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nij4t/terraform-provider-aws/internal/conns"
+	"github.com/nij4t/terraform-provider-aws/internal/tfresource"
+	"github.com/nij4t/terraform-provider-aws/internal/verify"
+)
+
+const (
+	instanceAutomatedBackupsReplicationCreateTimeout = 75 * time.Minute
+	instanceAutomatedBackupsReplicationDeleteTimeout = 75 * time.Minute
+)
+
+func ResourceInstanceAutomatedBackupsReplication() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceInstanceAutomatedBackupsReplicationCreate,
+		Read:   resourceInstanceAutomatedBackupsReplicationRead,
+		Delete: resourceInstanceAutomatedBackupsReplicationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(instanceAutomatedBackupsReplicationCreateTimeout),
+			Delete: schema.DefaultTimeout(instanceAutomatedBackupsReplicationDeleteTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"pre_signed_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"retention_period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      7,
+				ValidateFunc: validation.IntBetween(1, 35),
+			},
+			"source_db_instance_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceInstanceAutomatedBackupsReplicationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	sourceDBInstanceARN := d.Get("source_db_instance_arn").(string)
+	sourceARN, err := arn.Parse(sourceDBInstanceARN)
+
+	if err != nil {
+		return err
+	}
+
+	input := &rds.StartDBInstanceAutomatedBackupsReplicationInput{
+		BackupRetentionPeriod: aws.Int64(int64(d.Get("retention_period").(int))),
+		SourceDBInstanceArn:   aws.String(sourceDBInstanceARN),
+		// Setting SourceRegion has the SDK generate the pre-signed URL.
+		SourceRegion: aws.String(sourceARN.Region),
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("pre_signed_url"); ok {
+		input.PreSignedUrl = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Starting RDS DB Instance Automated Backups Replication: %s", input)
+	output, err := conn.StartDBInstanceAutomatedBackupsReplication(input)
+
+	if err != nil {
+		return fmt.Errorf("error starting RDS DB Instance (%s) Automated Backups Replication: %w", sourceDBInstanceARN, err)
+	}
+
+	d.SetId(aws.StringValue(output.DBInstanceAutomatedBackup.DBInstanceAutomatedBackupsArn))
+
+	if _, err := waitDBInstanceAutomatedBackupReplicating(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for RDS DB Instance Automated Backup (%s) to start replicating: %w", d.Id(), err)
+	}
+
+	return resourceInstanceAutomatedBackupsReplicationRead(d, meta)
+}
+
+func resourceInstanceAutomatedBackupsReplicationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	backup, err := FindDBInstanceAutomatedBackupByARN(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] RDS DB Instance Automated Backup (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading RDS DB Instance Automated Backup (%s): %w", d.Id(), err)
+	}
+
+	d.Set("kms_key_id", backup.KmsKeyId)
+	d.Set("retention_period", backup.BackupRetentionPeriod)
+	d.Set("source_db_instance_arn", backup.DBInstanceArn)
+
+	return nil
+}
+
+func resourceInstanceAutomatedBackupsReplicationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RDSConn
+
+	backup, err := FindDBInstanceAutomatedBackupByARN(conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading RDS DB Instance Automated Backup (%s): %w", d.Id(), err)
+	}
+
+	sourceDBInstanceARN := aws.StringValue(backup.DBInstanceArn)
+	sourceARN, err := arn.Parse(sourceDBInstanceARN)
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Stopping RDS DB Instance Automated Backups Replication: %s", d.Id())
+	_, err = conn.StopDBInstanceAutomatedBackupsReplication(&rds.StopDBInstanceAutomatedBackupsReplicationInput{
+		SourceDBInstanceArn: aws.String(sourceDBInstanceARN),
+	})
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBInstanceNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error stopping RDS DB Instance (%s) Automated Backups Replication: %w", sourceDBInstanceARN, err)
+	}
+
+	// The replication is tracked on the source DB instance, so wait in its Region.
+	session, err := conns.NewSessionForRegion(&conn.Config, sourceARN.Region, meta.(*conns.AWSClient).TerraformVersion)
+
+	if err != nil {
+		return fmt.Errorf("error creating AWS session: %w", err)
+	}
+
+	sourceConn := rds.New(session)
+
+	if _, err := waitDBInstanceAutomatedBackupsReplicationDeleted(sourceConn, aws.StringValue(backup.DBInstanceIdentifier), d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for RDS DB Instance Automated Backups Replication (%s) to stop: %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,204 @@
+package rds_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/nij4t/terraform-provider-aws/internal/acctest"
+	"github.com/nij4t/terraform-provider-aws/internal/conns"
+	tfrds "github.com/nij4t/terraform-provider-aws/internal/service/rds"
+	"github.com/nij4t/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccRDSInstanceAutomatedBackupsReplication_basic(t *testing.T) {
+	var providers []*schema.Provider
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance_automated_backups_replication.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, rds.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckInstanceAutomatedBackupsReplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceAutomatedBackupsReplicationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceAutomatedBackupsReplicationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "source_db_instance_arn", "aws_db_instance.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "retention_period", "7"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"pre_signed_url"},
+			},
+		},
+	})
+}
+
+func TestAccRDSInstanceAutomatedBackupsReplication_retentionPeriod(t *testing.T) {
+	var providers []*schema.Provider
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance_automated_backups_replication.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, rds.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckInstanceAutomatedBackupsReplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceAutomatedBackupsReplicationRetentionPeriodConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceAutomatedBackupsReplicationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "retention_period", "14"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSInstanceAutomatedBackupsReplication_kmsEncrypted(t *testing.T) {
+	var providers []*schema.Provider
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance_automated_backups_replication.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, rds.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckInstanceAutomatedBackupsReplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceAutomatedBackupsReplicationKMSEncryptedConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceAutomatedBackupsReplicationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "kms_key_id", "aws_kms_key.test", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckInstanceAutomatedBackupsReplicationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No RDS DB Instance Automated Backup ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn
+
+		_, err := tfrds.FindDBInstanceAutomatedBackupByARN(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckInstanceAutomatedBackupsReplicationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_db_instance_automated_backups_replication" {
+			continue
+		}
+
+		backup, err := tfrds.FindDBInstanceAutomatedBackupByARN(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		// Stopping replication leaves the already replicated backups retained until they expire.
+		if aws.StringValue(backup.Status) == tfrds.InstanceAutomatedBackupStatusRetained {
+			continue
+		}
+
+		return fmt.Errorf("RDS DB Instance Automated Backups Replication %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccInstanceAutomatedBackupsReplicationBaseConfig(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateRegionProvider(), fmt.Sprintf(`
+data "aws_rds_orderable_db_instance" "test" {
+  provider = "awsalternate"
+
+  engine                     = "postgres"
+  engine_version             = "13.4"
+  preferred_instance_classes = ["db.t3.micro", "db.t3.small", "db.t2.small"]
+}
+
+resource "aws_db_instance" "test" {
+  provider = "awsalternate"
+
+  allocated_storage       = 10
+  identifier              = %[1]q
+  engine                  = data.aws_rds_orderable_db_instance.test.engine
+  engine_version          = data.aws_rds_orderable_db_instance.test.engine_version
+  instance_class          = data.aws_rds_orderable_db_instance.test.instance_class
+  name                    = "mydb"
+  username                = "masterusername"
+  password                = "mustbeeightcharacters"
+  backup_retention_period = 7
+  skip_final_snapshot     = true
+}
+`, rName))
+}
+
+func testAccInstanceAutomatedBackupsReplicationConfig(rName string) string {
+	return acctest.ConfigCompose(testAccInstanceAutomatedBackupsReplicationBaseConfig(rName), `
+resource "aws_db_instance_automated_backups_replication" "test" {
+  source_db_instance_arn = aws_db_instance.test.arn
+}
+`)
+}
+
+func testAccInstanceAutomatedBackupsReplicationRetentionPeriodConfig(rName string) string {
+	return acctest.ConfigCompose(testAccInstanceAutomatedBackupsReplicationBaseConfig(rName), `
+resource "aws_db_instance_automated_backups_replication" "test" {
+  source_db_instance_arn = aws_db_instance.test.arn
+  retention_period       = 14
+}
+`)
+}
+
+func testAccInstanceAutomatedBackupsReplicationKMSEncryptedConfig(rName string) string {
+	return acctest.ConfigCompose(testAccInstanceAutomatedBackupsReplicationBaseConfig(rName), fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description = %[1]q
+}
+
+resource "aws_db_instance_automated_backups_replication" "test" {
+  source_db_instance_arn = aws_db_instance.test.arn
+  kms_key_id             = aws_kms_key.test.arn
+}
+`, rName))
+}
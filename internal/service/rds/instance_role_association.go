@@ -32,6 +32,11 @@ func ResourceInstanceRoleAssociation() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(InstanceRoleAssociationTimeout),
+			Delete: schema.DefaultTimeout(InstanceRoleAssociationTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"db_instance_identifier": {
 				Type:     schema.TypeString,
@@ -74,7 +79,7 @@ func resourceInstanceRoleAssociationCreate(d *schema.ResourceData, meta interfac
 
 	d.SetId(fmt.Sprintf("%s,%s", dbInstanceIdentifier, roleArn))
 
-	if err := waitForDBInstanceRoleAssociation(conn, dbInstanceIdentifier, roleArn); err != nil {
+	if err := waitForDBInstanceRoleAssociation(conn, dbInstanceIdentifier, roleArn, d.Timeout(schema.TimeoutCreate)); err != nil {
 		return fmt.Errorf("error waiting for RDS DB Instance (%s) IAM Role (%s) association: %s", dbInstanceIdentifier, roleArn, err)
 	}
 
@@ -145,7 +150,7 @@ func resourceInstanceRoleAssociationDelete(d *schema.ResourceData, meta interfac
 		return fmt.Errorf("error disassociating RDS DB Instance (%s) IAM Role (%s): %s", dbInstanceIdentifier, roleArn, err)
 	}
 
-	if err := WaitForInstanceRoleDisassociation(conn, dbInstanceIdentifier, roleArn); err != nil {
+	if err := WaitForInstanceRoleDisassociation(conn, dbInstanceIdentifier, roleArn, d.Timeout(schema.TimeoutDelete)); err != nil {
 		return fmt.Errorf("error waiting for RDS DB Instance (%s) IAM Role (%s) disassociation: %s", dbInstanceIdentifier, roleArn, err)
 	}
 
@@ -199,7 +204,7 @@ func DescribeInstanceRole(conn *rds.RDS, dbInstanceIdentifier, roleArn string) (
 	return dbInstanceRole, nil
 }
 
-func waitForDBInstanceRoleAssociation(conn *rds.RDS, dbInstanceIdentifier, roleArn string) error {
+func waitForDBInstanceRoleAssociation(conn *rds.RDS, dbInstanceIdentifier, roleArn string, timeout time.Duration) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{rdsDbInstanceRoleStatusPending},
 		Target:  []string{rdsDbInstanceRoleStatusActive},
@@ -216,7 +221,7 @@ func waitForDBInstanceRoleAssociation(conn *rds.RDS, dbInstanceIdentifier, roleA
 
 			return dbInstanceRole, aws.StringValue(dbInstanceRole.Status), nil
 		},
-		Timeout: 5 * time.Minute,
+		Timeout: timeout,
 		Delay:   5 * time.Second,
 	}
 
@@ -226,7 +231,7 @@ func waitForDBInstanceRoleAssociation(conn *rds.RDS, dbInstanceIdentifier, roleA
 	return err
 }
 
-func WaitForInstanceRoleDisassociation(conn *rds.RDS, dbInstanceIdentifier, roleArn string) error {
+func WaitForInstanceRoleDisassociation(conn *rds.RDS, dbInstanceIdentifier, roleArn string, timeout time.Duration) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{
 			rdsDbInstanceRoleStatusActive,
@@ -250,7 +255,7 @@ func WaitForInstanceRoleDisassociation(conn *rds.RDS, dbInstanceIdentifier, role
 
 			return &rds.DBInstanceRole{}, rdsDbInstanceRoleStatusDeleted, nil
 		},
-		Timeout: 5 * time.Minute,
+		Timeout: timeout,
 		Delay:   5 * time.Second,
 	}
 
@@ -58,9 +58,12 @@ func TestAccRDSInstance_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "max_allocated_storage", "0"),
 					resource.TestCheckResourceAttr(resourceName, "name", "baz"),
 					resource.TestCheckResourceAttr(resourceName, "option_group_name", "default:mysql-5-6"),
+					resource.TestCheckResourceAttr(resourceName, "parameter_apply_status", "in-sync"),
 					resource.TestCheckResourceAttr(resourceName, "parameter_group_name", "default.mysql5.6"),
+					resource.TestCheckResourceAttr(resourceName, "pending_reboot_parameters.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "port", "3306"),
 					resource.TestCheckResourceAttr(resourceName, "publicly_accessible", "false"),
+					resource.TestCheckResourceAttr(resourceName, "reboot_on_pending_parameters", "false"),
 					resource.TestCheckResourceAttrSet(resourceName, "resource_id"),
 					resource.TestCheckResourceAttr(resourceName, "status", "available"),
 					resource.TestCheckResourceAttr(resourceName, "storage_encrypted", "false"),
@@ -84,6 +87,58 @@ func TestAccRDSInstance_basic(t *testing.T) {
 	})
 }
 
+func TestAccRDSInstance_rebootOnPendingParameters(t *testing.T) {
+	var dbInstance rds.DBInstance
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, rds.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_RebootOnPendingParameters(rName, "1", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &dbInstance),
+					resource.TestCheckResourceAttr(resourceName, "applied_parameters.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "parameter_apply_status", "in-sync"),
+					resource.TestCheckResourceAttr(resourceName, "pending_reboot_parameters.#", "0"),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_RebootOnPendingParameters(rName, "0", false),
+			},
+			{
+				// Refresh to pick up the parameter group change on the instance.
+				// Parameters pending reboot are listed even though the reboot isn't managed.
+				Config: testAccInstanceConfig_RebootOnPendingParameters(rName, "0", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &dbInstance),
+					resource.TestCheckResourceAttr(resourceName, "parameter_apply_status", tfrds.InstanceParameterApplyStatusPendingReboot),
+					resource.TestCheckResourceAttr(resourceName, "applied_parameters.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "applied_parameters.*", "character_set_server"),
+					resource.TestCheckResourceAttr(resourceName, "pending_reboot_parameters.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "pending_reboot_parameters.*", "performance_schema"),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_RebootOnPendingParameters(rName, "0", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &dbInstance),
+					resource.TestCheckResourceAttr(resourceName, "applied_parameters.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "applied_parameters.*", "character_set_server"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "applied_parameters.*", "performance_schema"),
+					resource.TestCheckResourceAttr(resourceName, "parameter_apply_status", "in-sync"),
+					resource.TestCheckResourceAttr(resourceName, "pending_reboot_parameters.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "reboot_on_pending_parameters", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRDSInstance_onlyMajorVersion(t *testing.T) {
 	var dbInstance1 rds.DBInstance
 	resourceName := "aws_db_instance.test"
@@ -3613,6 +3668,41 @@ resource "aws_db_instance" "bar" {
 `)
 }
 
+func testAccInstanceConfig_RebootOnPendingParameters(rName, performanceSchema string, reboot bool) string {
+	return acctest.ConfigCompose(testAccInstanceConfig_orderableClassMySQL(), fmt.Sprintf(`
+resource "aws_db_parameter_group" "test" {
+  family = "mysql5.6"
+  name   = %[1]q
+
+  parameter {
+    name         = "character_set_server"
+    value        = "utf8"
+    apply_method = "immediate"
+  }
+
+  parameter {
+    name         = "performance_schema"
+    value        = %[2]q
+    apply_method = "pending-reboot"
+  }
+}
+
+resource "aws_db_instance" "test" {
+  allocated_storage            = 10
+  backup_retention_period      = 0
+  engine                       = data.aws_rds_orderable_db_instance.test.engine
+  engine_version               = data.aws_rds_orderable_db_instance.test.engine_version
+  identifier                   = %[1]q
+  instance_class               = data.aws_rds_orderable_db_instance.test.instance_class
+  parameter_group_name         = aws_db_parameter_group.test.id
+  password                     = "avoid-plaintext-passwords"
+  reboot_on_pending_parameters = %[3]t
+  skip_final_snapshot          = true
+  username                     = "tfacctest"
+}
+`, rName, performanceSchema, reboot))
+}
+
 func testAccInstanceConfig_MajorVersionOnly(engine, engineVersion string) string {
 	return acctest.ConfigCompose(testAccInstanceConfig_orderableClassMySQL(), fmt.Sprintf(`
 locals {
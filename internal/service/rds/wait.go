@@ -1,10 +1,14 @@
 package rds
 
 import (
+	"context"
+	"errors"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/nij4t/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -12,6 +16,8 @@ const (
 
 	dbClusterRoleAssociationCreatedTimeout = 5 * time.Minute
 	dbClusterRoleAssociationDeletedTimeout = 5 * time.Minute
+
+	InstanceRoleAssociationTimeout = 5 * time.Minute
 )
 
 func waitEventSubscriptionCreated(conn *rds.RDS, id string, timeout time.Duration) (*rds.EventSubscription, error) {
@@ -110,12 +116,12 @@ func waitDBProxyEndpointDeleted(conn *rds.RDS, id string, timeout time.Duration)
 	return nil, err
 }
 
-func waitDBClusterRoleAssociationCreated(conn *rds.RDS, dbClusterID, roleARN string) (*rds.DBClusterRole, error) {
+func waitDBClusterRoleAssociationCreated(conn *rds.RDS, dbClusterID, roleARN string, timeout time.Duration) (*rds.DBClusterRole, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{ClusterRoleStatusPending},
 		Target:  []string{ClusterRoleStatusActive},
 		Refresh: statusDBClusterRole(conn, dbClusterID, roleARN),
-		Timeout: dbClusterRoleAssociationCreatedTimeout,
+		Timeout: timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForState()
@@ -127,12 +133,12 @@ func waitDBClusterRoleAssociationCreated(conn *rds.RDS, dbClusterID, roleARN str
 	return nil, err
 }
 
-func waitDBClusterRoleAssociationDeleted(conn *rds.RDS, dbClusterID, roleARN string) (*rds.DBClusterRole, error) {
+func waitDBClusterRoleAssociationDeleted(conn *rds.RDS, dbClusterID, roleARN string, timeout time.Duration) (*rds.DBClusterRole, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{ClusterRoleStatusActive, ClusterRoleStatusPending},
 		Target:  []string{},
 		Refresh: statusDBClusterRole(conn, dbClusterID, roleARN),
-		Timeout: dbClusterRoleAssociationDeletedTimeout,
+		Timeout: timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForState()
@@ -198,3 +204,120 @@ func waitDBClusterInstanceDeleted(conn *rds.RDS, id string, timeout time.Duratio
 
 	return nil, err
 }
+
+func waitDBInstanceAutomatedBackupReplicating(conn *rds.RDS, arn string, timeout time.Duration) (*rds.DBInstanceAutomatedBackup, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{InstanceAutomatedBackupStatusPending},
+		Target:     []string{InstanceAutomatedBackupStatusReplicating},
+		Refresh:    statusDBInstanceAutomatedBackup(conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*rds.DBInstanceAutomatedBackup); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDBInstanceAutomatedBackupsReplicationDeleted(conn *rds.RDS, dbInstanceID, backupARN string, timeout time.Duration) (*rds.DBInstanceAutomatedBackupsReplication, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{InstanceAutomatedBackupStatusReplicating},
+		Target:     []string{},
+		Refresh:    statusDBInstanceAutomatedBackupsReplication(conn, dbInstanceID, backupARN),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*rds.DBInstanceAutomatedBackupsReplication); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitExportTaskCompleted(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) (*rds.ExportTask, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ExportTaskStatusStarting, ExportTaskStatusInProgress},
+		Target:     []string{ExportTaskStatusComplete},
+		Refresh:    statusExportTask(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rds.ExportTask); ok {
+		if failureCause := aws.StringValue(output.FailureCause); failureCause != "" {
+			tfresource.SetLastError(err, errors.New(failureCause))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitExportTaskCanceled(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) (*rds.ExportTask, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ExportTaskStatusStarting, ExportTaskStatusInProgress, ExportTaskStatusCanceling},
+		Target:     []string{ExportTaskStatusCanceled},
+		Refresh:    statusExportTask(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rds.ExportTask); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitActivityStreamStarted(conn *rds.RDS, arn string, timeout time.Duration) (*rds.DBCluster, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{rds.ActivityStreamStatusStarting},
+		Target:     []string{rds.ActivityStreamStatusStarted},
+		Refresh:    statusDBClusterActivityStream(conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*rds.DBCluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitActivityStreamStopped(conn *rds.RDS, arn string, timeout time.Duration) (*rds.DBCluster, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{rds.ActivityStreamStatusStopping},
+		Target:     []string{rds.ActivityStreamStatusStopped},
+		Refresh:    statusDBClusterActivityStream(conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*rds.DBCluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -17,9 +17,11 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -27,6 +29,7 @@ import (
 	"github.com/nij4t/terraform-provider-aws/internal/conns"
 	"github.com/nij4t/terraform-provider-aws/internal/create"
 	"github.com/nij4t/terraform-provider-aws/internal/flex"
+	tfiam "github.com/nij4t/terraform-provider-aws/internal/service/iam"
 	tftags "github.com/nij4t/terraform-provider-aws/internal/tags"
 	"github.com/nij4t/terraform-provider-aws/internal/tfresource"
 	"github.com/nij4t/terraform-provider-aws/internal/verify"
@@ -665,7 +668,10 @@ func ResourceBucket() *schema.Resource {
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			tfiam.PolicyValidationCustomizeDiff("policy", accessanalyzer.PolicyTypeResourcePolicy),
+		),
 	}
 }
 
@@ -673,14 +679,7 @@ func resourceBucketCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).S3Conn
 
 	// Get the bucket and acl
-	var bucket string
-	if v, ok := d.GetOk("bucket"); ok {
-		bucket = v.(string)
-	} else if v, ok := d.GetOk("bucket_prefix"); ok {
-		bucket = resource.PrefixedUniqueId(v.(string))
-	} else {
-		bucket = resource.UniqueId()
-	}
+	bucket := create.Name(meta.(*conns.AWSClient).NamingPolicy, d.Get("bucket").(string), d.Get("bucket_prefix").(string))
 	d.Set("bucket", bucket)
 
 	log.Printf("[DEBUG] S3 bucket create: %s", bucket)
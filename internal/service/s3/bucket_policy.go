@@ -7,12 +7,14 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/nij4t/terraform-provider-aws/internal/conns"
+	tfiam "github.com/nij4t/terraform-provider-aws/internal/service/iam"
 	"github.com/nij4t/terraform-provider-aws/internal/tfresource"
 	"github.com/nij4t/terraform-provider-aws/internal/verify"
 )
@@ -41,6 +43,8 @@ func ResourceBucketPolicy() *schema.Resource {
 				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
 			},
 		},
+
+		CustomizeDiff: tfiam.PolicyValidationCustomizeDiff("policy", accessanalyzer.PolicyTypeResourcePolicy),
 	}
 }
 
@@ -5058,3 +5058,40 @@ resource "aws_s3_bucket" "test" {
   bucket_prefix = "tf-test-"
 }
 `
+
+func TestS3Bucket_fakeAWS(t *testing.T) {
+	p, _ := acctest.FakeAWSProvider(t)
+	r := acctest.NewFakeAWSResource(t, p, "aws_s3_bucket")
+	bucketName := sdkacctest.RandomWithPrefix("tf-test-bucket")
+
+	state := r.Apply(map[string]interface{}{
+		"bucket": bucketName,
+	})
+
+	if got, want := state.Attributes["region"], acctest.Region(); got != want {
+		t.Errorf("region = %q, want %q", got, want)
+	}
+
+	state = r.Apply(map[string]interface{}{
+		"bucket": bucketName,
+		"versioning": []interface{}{
+			map[string]interface{}{
+				"enabled": true,
+			},
+		},
+		"tags": map[string]interface{}{
+			"key1": "value1",
+		},
+	})
+
+	if got, want := state.Attributes["versioning.0.enabled"], "true"; got != want {
+		t.Errorf("versioning.0.enabled = %q, want %q", got, want)
+	}
+
+	if got, want := state.Attributes["tags.key1"], "value1"; got != want {
+		t.Errorf("tags.key1 = %q, want %q", got, want)
+	}
+
+	r.ImportStateVerify("force_destroy", "acl")
+	r.Destroy()
+}
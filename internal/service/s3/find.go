@@ -0,0 +1,45 @@
+package s3
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+// FindBucketNamesByRegion returns the names of the buckets located in a region.
+// Buckets whose location cannot be read are skipped.
+func FindBucketNamesByRegion(ctx context.Context, conn *s3.S3, region string) ([]string, error) {
+	output, err := conn.ListBucketsWithContext(ctx, &s3.ListBucketsInput{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+
+	for _, bucket := range output.Buckets {
+		if bucket == nil {
+			continue
+		}
+
+		name := aws.StringValue(bucket.Name)
+		location, err := conn.GetBucketLocationWithContext(ctx, &s3.GetBucketLocationInput{
+			Bucket: aws.String(name),
+		})
+
+		if err != nil {
+			log.Printf("[WARN] Unable to read S3 Bucket (%s) location, skipping: %s", name, err)
+			continue
+		}
+
+		if s3.NormalizeBucketLocation(aws.StringValue(location.LocationConstraint)) != region {
+			continue
+		}
+
+		names = append(names, name)
+	}
+
+	return names, nil
+}
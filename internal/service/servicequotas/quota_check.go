@@ -0,0 +1,218 @@
+package servicequotas
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nij4t/terraform-provider-aws/internal/conns"
+)
+
+// QuotaCheck describes a service quota consumed by the resources of a type.
+type QuotaCheck struct {
+	// ServiceCode and QuotaCode identify the quota in Service Quotas.
+	ServiceCode string
+	QuotaCode   string
+
+	// Description names the quota in messages, e.g. "VPCs per Region".
+	Description string
+
+	// Reserved is the part of the quota that cannot be consumed by resources.
+	Reserved float64
+
+	// Usage returns the current usage of the quota.
+	Usage func(ctx context.Context, client *conns.AWSClient) (float64, error)
+
+	// Planned returns the usage of the quota added by a planned change of a resource.
+	Planned func(diff *schema.ResourceDiff) float64
+}
+
+// PlannedCreate returns a usage of one for a planned create, for quotas on the number of resources.
+func PlannedCreate(diff *schema.ResourceDiff) float64 {
+	if diff.Id() == "" {
+		return 1
+	}
+
+	return 0
+}
+
+// quotaUsage is the applied value and usage of a quota, and the usage added by the changes planned so far.
+type quotaUsage struct {
+	value float64
+	usage float64
+	err   error
+
+	// planned is the usage planned for existing resources, by resource ID,
+	// so that planning a resource's change more than once only counts it once.
+	planned map[string]float64
+
+	// plannedCreates is the usage planned for new resources, which have no ID.
+	plannedCreates float64
+}
+
+// setPlanned records the usage planned for a resource, replacing any usage planned for it before,
+// and returns the total planned usage.
+func (q *quotaUsage) setPlanned(id string, planned float64) float64 {
+	if id == "" {
+		q.plannedCreates += planned
+	} else {
+		if q.planned == nil {
+			q.planned = make(map[string]float64)
+		}
+
+		q.planned[id] = planned
+	}
+
+	total := q.plannedCreates
+
+	for _, v := range q.planned {
+		total += v
+	}
+
+	return total
+}
+
+// quotaUsageKey identifies a quota of the account and region of a provider configuration.
+// The AWS client is used rather than its account ID, which is empty when the
+// provider is configured not to request it.
+type quotaUsageKey struct {
+	client      *conns.AWSClient
+	serviceCode string
+	quotaCode   string
+}
+
+var (
+	quotaUsages     = make(map[quotaUsageKey]*quotaUsage)
+	quotaUsagesLock sync.Mutex
+)
+
+// QuotaCheckCustomizeDiff returns a CustomizeDiffFunc that, when the
+// provider's quota_checks setting is enabled, adds the usage of the quota
+// planned for the resource to the current usage and the usage planned for
+// other resources, and fails the plan if the total exceeds the applied quota.
+//
+// The applied quota and current usage are read once per provider configuration,
+// so once per plan or apply, and the planned usage accumulates across all
+// the resources planned with the configuration. A replaced resource is counted as
+// if it is created, and only once, although the SDK plans its change twice.
+func QuotaCheckCustomizeDiff(check QuotaCheck) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		client := meta.(*conns.AWSClient)
+
+		if !client.QuotaChecks {
+			return nil
+		}
+
+		planned := check.Planned(diff)
+
+		if planned <= 0 {
+			return nil
+		}
+
+		quotaUsagesLock.Lock()
+		defer quotaUsagesLock.Unlock()
+
+		key := quotaUsageKey{
+			client:      client,
+			serviceCode: check.ServiceCode,
+			quotaCode:   check.QuotaCode,
+		}
+		quota, ok := quotaUsages[key]
+
+		if !ok {
+			quota = findQuotaUsage(ctx, client, check)
+			quotaUsages[key] = quota
+		}
+
+		if quota.err != nil {
+			return fmt.Errorf("error checking %s quota (%s/%s): %w", check.Description, check.ServiceCode, check.QuotaCode, quota.err)
+		}
+
+		totalPlanned := quota.setPlanned(plannedResourceID(diff), planned)
+		available := quota.value - check.Reserved
+
+		if total := quota.usage + totalPlanned; total <= available {
+			return nil
+		}
+
+		return fmt.Errorf("%s quota (%s/%s) would be exceeded: current usage %g and planned usage %g, of %g available",
+			check.Description, check.ServiceCode, check.QuotaCode, quota.usage, totalPlanned, available)
+	}
+}
+
+// plannedResourceID returns the ID of the resource whose change is planned, or "" for a new resource.
+// The SDK plans the replacement of a resource a second time without its state, as if it is created,
+// so the ID is also read from the prior state sent by Terraform.
+func plannedResourceID(diff *schema.ResourceDiff) string {
+	if id := diff.Id(); id != "" {
+		return id
+	}
+
+	state := diff.GetRawState()
+
+	if state.IsNull() || !state.IsKnown() || !state.Type().IsObjectType() || !state.Type().HasAttribute("id") {
+		return ""
+	}
+
+	if v := state.GetAttr("id"); v.IsKnown() && !v.IsNull() && v.Type() == cty.String {
+		return v.AsString()
+	}
+
+	return ""
+}
+
+func findQuotaUsage(ctx context.Context, client *conns.AWSClient, check QuotaCheck) *quotaUsage {
+	value, err := FindQuotaValue(ctx, client.ServiceQuotasConn, check.ServiceCode, check.QuotaCode)
+
+	if err != nil {
+		return &quotaUsage{err: err}
+	}
+
+	usage, err := check.Usage(ctx, client)
+
+	if err != nil {
+		return &quotaUsage{err: fmt.Errorf("error reading usage: %w", err)}
+	}
+
+	return &quotaUsage{value: value, usage: usage}
+}
+
+// FindQuotaValue returns the applied value of a quota, or its default value if none has been applied.
+func FindQuotaValue(ctx context.Context, conn *servicequotas.ServiceQuotas, serviceCode, quotaCode string) (float64, error) {
+	input := &servicequotas.GetServiceQuotaInput{
+		QuotaCode:   aws.String(quotaCode),
+		ServiceCode: aws.String(serviceCode),
+	}
+
+	output, err := conn.GetServiceQuotaWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, servicequotas.ErrCodeNoSuchResourceException) {
+		defaultOutput, err := conn.GetAWSDefaultServiceQuotaWithContext(ctx, &servicequotas.GetAWSDefaultServiceQuotaInput{
+			QuotaCode:   aws.String(quotaCode),
+			ServiceCode: aws.String(serviceCode),
+		})
+
+		if err != nil {
+			return 0, fmt.Errorf("error getting Service Quotas Default Service Quota (%s/%s): %w", serviceCode, quotaCode, err)
+		}
+
+		output = &servicequotas.GetServiceQuotaOutput{}
+
+		if defaultOutput != nil {
+			output.Quota = defaultOutput.Quota
+		}
+	} else if err != nil {
+		return 0, fmt.Errorf("error getting Service Quotas Service Quota (%s/%s): %w", serviceCode, quotaCode, err)
+	}
+
+	if output == nil || output.Quota == nil || output.Quota.Value == nil {
+		return 0, fmt.Errorf("error getting Service Quotas Service Quota (%s/%s): empty result", serviceCode, quotaCode)
+	}
+
+	return aws.Float64Value(output.Quota.Value), nil
+}
@@ -0,0 +1,165 @@
+package servicequotas
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/nij4t/terraform-provider-aws/internal/conns"
+)
+
+func testQuotaCheckResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+		CustomizeDiff: QuotaCheckCustomizeDiff(QuotaCheck{
+			ServiceCode: "test",
+			QuotaCode:   "L-12345678",
+			Description: "Tests per Region",
+			Reserved:    1,
+			Planned:     PlannedCreate,
+		}),
+	}
+}
+
+func TestQuotaCheckCustomizeDiff(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		QuotaChecks   bool
+		ExpectedError string
+	}{
+		{
+			Name: "disabled",
+		},
+		{
+			Name:          "enabled",
+			QuotaChecks:   true,
+			ExpectedError: "Tests per Region quota (test/L-12345678) would be exceeded: current usage 2 and planned usage 2, of 3 available",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			client := &conns.AWSClient{
+				AccountID:   "123456789012",
+				Region:      "us-west-2",
+				QuotaChecks: testCase.QuotaChecks,
+			}
+
+			// The applied quota and usage are read once, so seed them.
+			quotaUsagesLock.Lock()
+			quotaUsages = map[quotaUsageKey]*quotaUsage{
+				{client: client, serviceCode: "test", quotaCode: "L-12345678"}: {value: 4, usage: 2},
+			}
+			quotaUsagesLock.Unlock()
+
+			r := testQuotaCheckResource()
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{"name": "test"})
+
+			// The first create fits...
+			if _, err := r.Diff(context.Background(), nil, config, client); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			// ...and an update does not count.
+			state := &terraform.InstanceState{
+				ID:         "test",
+				Attributes: map[string]string{"id": "test", "name": "old"},
+			}
+
+			if _, err := r.Diff(context.Background(), state, config, client); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			// The second create exceeds the quota.
+			_, err := r.Diff(context.Background(), nil, config, client)
+
+			if testCase.ExpectedError == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), testCase.ExpectedError) {
+				t.Errorf("got error %v, want %q", err, testCase.ExpectedError)
+			}
+		})
+	}
+}
+
+func TestQuotaCheckCustomizeDiffReplacement(t *testing.T) {
+	client := &conns.AWSClient{
+		AccountID:   "123456789012",
+		Region:      "us-west-2",
+		QuotaChecks: true,
+	}
+
+	quotaUsagesLock.Lock()
+	quotaUsages = map[quotaUsageKey]*quotaUsage{
+		{client: client, serviceCode: "test", quotaCode: "L-12345678"}: {value: 2},
+	}
+	quotaUsagesLock.Unlock()
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"size": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+		CustomizeDiff: QuotaCheckCustomizeDiff(QuotaCheck{
+			ServiceCode: "test",
+			QuotaCode:   "L-12345678",
+			Description: "Tests per Region",
+			Planned: func(diff *schema.ResourceDiff) float64 {
+				return 1
+			},
+		}),
+	}
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{"name": "test", "size": 2})
+	state := &terraform.InstanceState{
+		ID:         "test",
+		Attributes: map[string]string{"id": "test", "name": "test", "size": "1"},
+		RawState: cty.ObjectVal(map[string]cty.Value{
+			"id":   cty.StringVal("test"),
+			"name": cty.StringVal("test"),
+			"size": cty.NumberIntVal(1),
+		}),
+	}
+
+	// The SDK plans the replacement twice, and planning it again still counts it once.
+	for i := 0; i < 2; i++ {
+		if _, err := r.Diff(context.Background(), state, config, client); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	config = terraform.NewResourceConfigRaw(map[string]interface{}{"name": "test"})
+
+	// A create fits...
+	if _, err := r.Diff(context.Background(), nil, config, client); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// ...and a second create exceeds the quota.
+	_, err := r.Diff(context.Background(), nil, config, client)
+
+	if want := "current usage 0 and planned usage 3, of 2 available"; err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("got error %v, want %q", err, want)
+	}
+}
@@ -39,9 +39,13 @@ func ResourceStateMachine() *schema.Resource {
 			},
 
 			"definition": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.StringLenBetween(0, 1024*1024), // 1048576
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(0, 1024*1024), // 1048576
+					validStateMachineDefinition,
+				),
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
 			},
 
 			"logging_configuration": {
@@ -303,6 +303,48 @@ func TestAccSFNStateMachine_expressLogging(t *testing.T) {
 	})
 }
 
+func TestAccSFNStateMachine_definitionEquivalent(t *testing.T) {
+	var sm sfn.DescribeStateMachineOutput
+	resourceName := "aws_sfn_state_machine.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sfn.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckStateMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStateMachineConfig(rName, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(resourceName, &sm),
+				),
+			},
+			{
+				Config:   testAccStateMachineReorderedConfig(rName, 5),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccSFNStateMachine_definitionValidation(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sfn.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckStateMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStateMachineInvalidDefinitionConfig(rName),
+				ExpectError: regexp.MustCompile(`States.HelloWorld: Next "Missing" does not match any state`),
+			},
+		},
+	})
+}
+
 func testAccCheckExists(n string, v *sfn.DescribeStateMachineOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -709,3 +751,56 @@ EOF
 }
 `, rName))
 }
+
+func testAccStateMachineReorderedConfig(rName string, rMaxAttempts int) string {
+	return acctest.ConfigCompose(testAccStateMachineBaseConfig(rName), fmt.Sprintf(`
+resource "aws_sfn_state_machine" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.for_sfn.arn
+
+  definition = jsonencode({
+    States = {
+      HelloWorld = {
+        End      = true
+        Resource = aws_lambda_function.test.arn
+        Retry = [{
+          BackoffRate     = 8
+          ErrorEquals     = ["States.ALL"]
+          IntervalSeconds = 5
+          MaxAttempts     = %[2]d
+        }]
+        Type = "Task"
+      }
+    }
+    StartAt = "HelloWorld"
+    Comment = "A Hello World example of the Amazon States Language using an AWS Lambda Function"
+  })
+}
+`, rName, rMaxAttempts))
+}
+
+func testAccStateMachineInvalidDefinitionConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_sfn_state_machine" "test" {
+  name     = %[1]q
+  role_arn = "arn:${data.aws_partition.current.partition}:iam::123456789012:role/test"
+
+  definition = <<EOF
+{
+  "StartAt": "HelloWorld",
+  "States": {
+    "HelloWorld": {
+      "Type": "Pass",
+      "Next": "Missing"
+    },
+    "Done": {
+      "Type": "Succeed"
+    }
+  }
+}
+EOF
+}
+`, rName)
+}
@@ -1,8 +1,17 @@
 package sfn
 
 import (
+	"encoding/json"
 	"fmt"
 	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	statesLanguageQueryLanguageJSONata  = "JSONata"
+	statesLanguageQueryLanguageJSONPath = "JSONPath"
 )
 
 func validStateMachineName(v interface{}, k string) (ws []string, errors []error) {
@@ -17,3 +26,302 @@ func validStateMachineName(v interface{}, k string) (ws []string, errors []error
 	}
 	return
 }
+
+// validStateMachineDefinition checks the structure of an Amazon States Language definition:
+// it must be a JSON object whose StartAt names a state, whose transitions (Next, Default and
+// Catch) resolve to states in the same scope and which has at least one terminal state.
+// Parallel branches and Map iterators are checked as nested state machines.
+// Choice Rules of JSONPath states must be a single comparison on a Variable or a boolean And,
+// Or or Not of nested rules; those of JSONata states are left to the API, as are other state fields.
+// States of an unknown Type only produce a warning.
+// See https://states-language.net/spec.html.
+func validStateMachineDefinition(v interface{}, k string) (ws []string, errors []error) {
+	var definition map[string]interface{}
+
+	if err := json.Unmarshal([]byte(v.(string)), &definition); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid JSON object: %w", k, err))
+		return
+	}
+
+	warnings, errs := validateStatesLanguageMachine(definition, statesLanguageQueryLanguageJSONPath, "")
+
+	for _, warning := range warnings {
+		ws = append(ws, fmt.Sprintf("%q: %s", k, warning))
+	}
+
+	for _, err := range errs {
+		errors = append(errors, fmt.Errorf("%q contains an invalid Amazon States Language definition: %w", k, err))
+	}
+
+	return
+}
+
+func validateStatesLanguageMachine(machine map[string]interface{}, queryLanguage, path string) ([]string, []error) {
+	var ws []string
+	var errs []error
+
+	if v, ok := machine["QueryLanguage"].(string); ok {
+		queryLanguage = v
+	}
+
+	states, ok := machine["States"].(map[string]interface{})
+
+	if !ok || len(states) == 0 {
+		return ws, append(errs, fmt.Errorf("%sStates must be a non-empty object", path))
+	}
+
+	startAt, ok := machine["StartAt"].(string)
+
+	if !ok || startAt == "" {
+		errs = append(errs, fmt.Errorf("%sStartAt must be a non-empty string", path))
+	} else if _, ok := states[startAt]; !ok {
+		errs = append(errs, fmt.Errorf("%sStartAt %q does not match any state", path, startAt))
+	}
+
+	names := make([]string, 0, len(states))
+
+	for name := range states {
+		names = append(names, name)
+	}
+
+	// Report errors in a stable order.
+	sort.Strings(names)
+
+	terminal := false
+
+	for _, name := range names {
+		statePath := fmt.Sprintf("%sStates.%s: ", path, name)
+		state, ok := states[name].(map[string]interface{})
+
+		if !ok {
+			errs = append(errs, fmt.Errorf("%smust be an object", statePath))
+			continue
+		}
+
+		isTerminal, stateWs, stateErrs := validateStatesLanguageState(state, states, queryLanguage, statePath)
+		terminal = terminal || isTerminal
+		ws = append(ws, stateWs...)
+		errs = append(errs, stateErrs...)
+	}
+
+	if !terminal {
+		errs = append(errs, fmt.Errorf("%sStates must include a terminal state (End, Succeed or Fail)", path))
+	}
+
+	return ws, errs
+}
+
+func validateStatesLanguageState(state, states map[string]interface{}, queryLanguage, path string) (bool, []string, []error) {
+	var ws []string
+	var errs []error
+
+	if v, ok := state["QueryLanguage"].(string); ok {
+		queryLanguage = v
+	}
+
+	checkTransition := func(field string, v interface{}) {
+		next, ok := v.(string)
+
+		if !ok || next == "" {
+			errs = append(errs, fmt.Errorf("%s%s must be a non-empty string", path, field))
+			return
+		}
+
+		if _, ok := states[next]; !ok {
+			errs = append(errs, fmt.Errorf("%s%s %q does not match any state", path, field, next))
+		}
+	}
+
+	stateType, _ := state["Type"].(string)
+	next, hasNext := state["Next"]
+	end, _ := state["End"].(bool)
+
+	switch stateType {
+	case "Choice":
+		if hasNext || end {
+			errs = append(errs, fmt.Errorf("%sChoice state cannot have Next or End", path))
+		}
+
+		choices, ok := state["Choices"].([]interface{})
+
+		if !ok || len(choices) == 0 {
+			errs = append(errs, fmt.Errorf("%sChoices must be a non-empty array", path))
+		}
+
+		for i, v := range choices {
+			rulePath := fmt.Sprintf("%sChoices[%d]: ", path, i)
+			rule, ok := v.(map[string]interface{})
+
+			if !ok {
+				errs = append(errs, fmt.Errorf("%smust be an object", rulePath))
+				continue
+			}
+
+			// JSONata Choice Rules are a single Condition expression.
+			if queryLanguage != statesLanguageQueryLanguageJSONata {
+				errs = append(errs, validateStatesLanguageChoiceRule(rule, rulePath)...)
+			}
+
+			// Only top-level Choice Rules transition.
+			checkTransition("Choices["+strconv.Itoa(i)+"].Next", rule["Next"])
+		}
+
+		if v, ok := state["Default"]; ok {
+			checkTransition("Default", v)
+		}
+
+		return false, ws, errs
+	case "Fail", "Succeed":
+		if hasNext || end {
+			errs = append(errs, fmt.Errorf("%s%s state cannot have Next or End", path, stateType))
+		}
+
+		return true, ws, errs
+	case "Map", "Parallel", "Pass", "Task", "Wait":
+		switch {
+		case hasNext && end:
+			errs = append(errs, fmt.Errorf("%sNext and End cannot both be set", path))
+		case hasNext:
+			checkTransition("Next", next)
+		case !end:
+			errs = append(errs, fmt.Errorf("%sone of Next or End must be set", path))
+		}
+	case "":
+		return false, ws, append(errs, fmt.Errorf("%sType must be a non-empty string", path))
+	default:
+		// Newer state types are accepted; only check their transitions.
+		ws = append(ws, fmt.Sprintf("%sunknown Type %q is not validated", path, stateType))
+
+		if hasNext {
+			checkTransition("Next", next)
+		}
+
+		return end, ws, errs
+	}
+
+	if v, ok := state["Catch"]; ok {
+		catchers, _ := v.([]interface{})
+
+		for i, v := range catchers {
+			catcher, _ := v.(map[string]interface{})
+			checkTransition("Catch["+strconv.Itoa(i)+"].Next", catcher["Next"])
+		}
+	}
+
+	switch stateType {
+	case "Parallel":
+		branches, ok := state["Branches"].([]interface{})
+
+		if !ok || len(branches) == 0 {
+			errs = append(errs, fmt.Errorf("%sBranches must be a non-empty array", path))
+		}
+
+		for i, v := range branches {
+			branchPath := fmt.Sprintf("%sBranches[%d].", path, i)
+			branch, ok := v.(map[string]interface{})
+
+			if !ok {
+				errs = append(errs, fmt.Errorf("%s must be an object", strings.TrimSuffix(branchPath, ".")))
+				continue
+			}
+
+			branchWs, branchErrs := validateStatesLanguageMachine(branch, queryLanguage, branchPath)
+			ws = append(ws, branchWs...)
+			errs = append(errs, branchErrs...)
+		}
+	case "Map":
+		field := "Iterator"
+		iterator, ok := state[field]
+
+		if !ok {
+			field = "ItemProcessor"
+			iterator, ok = state[field]
+		}
+
+		if machine, isObject := iterator.(map[string]interface{}); !ok || !isObject {
+			errs = append(errs, fmt.Errorf("%sIterator must be an object", path))
+		} else {
+			iteratorWs, iteratorErrs := validateStatesLanguageMachine(machine, queryLanguage, path+field+".")
+			ws = append(ws, iteratorWs...)
+			errs = append(errs, iteratorErrs...)
+		}
+	}
+
+	return end, ws, errs
+}
+
+var statesLanguageComparisonOperators = func() map[string]bool {
+	operators := map[string]bool{
+		"BooleanEquals":     true,
+		"BooleanEqualsPath": true,
+		"IsBoolean":         true,
+		"IsNull":            true,
+		"IsNumeric":         true,
+		"IsPresent":         true,
+		"IsString":          true,
+		"IsTimestamp":       true,
+		"StringMatches":     true,
+	}
+
+	for _, prefix := range []string{"Numeric", "String", "Timestamp"} {
+		for _, suffix := range []string{"Equals", "GreaterThan", "GreaterThanEquals", "LessThan", "LessThanEquals"} {
+			operators[prefix+suffix] = true
+			operators[prefix+suffix+"Path"] = true
+		}
+	}
+
+	return operators
+}()
+
+// validateStatesLanguageChoiceRule checks that a Choice Rule is either a single comparison on
+// a Variable or a boolean And, Or or Not of nested rules.
+func validateStatesLanguageChoiceRule(rule map[string]interface{}, path string) []error {
+	var errs []error
+	var operators []string
+
+	for key := range rule {
+		if key == "And" || key == "Not" || key == "Or" || statesLanguageComparisonOperators[key] {
+			operators = append(operators, key)
+		}
+	}
+
+	if len(operators) != 1 {
+		sort.Strings(operators)
+		return append(errs, fmt.Errorf("%smust have exactly one comparison operator or And, Or or Not, got %v", path, operators))
+	}
+
+	switch operator := operators[0]; operator {
+	case "And", "Or":
+		rules, ok := rule[operator].([]interface{})
+
+		if !ok || len(rules) == 0 {
+			return append(errs, fmt.Errorf("%s%s must be a non-empty array", path, operator))
+		}
+
+		for i, v := range rules {
+			nestedPath := fmt.Sprintf("%s%s[%d]: ", path, operator, i)
+			nested, ok := v.(map[string]interface{})
+
+			if !ok {
+				errs = append(errs, fmt.Errorf("%smust be an object", nestedPath))
+				continue
+			}
+
+			errs = append(errs, validateStatesLanguageChoiceRule(nested, nestedPath)...)
+		}
+	case "Not":
+		nested, ok := rule[operator].(map[string]interface{})
+
+		if !ok {
+			return append(errs, fmt.Errorf("%sNot must be an object", path))
+		}
+
+		errs = append(errs, validateStatesLanguageChoiceRule(nested, path+"Not: ")...)
+	default:
+		if v, ok := rule["Variable"].(string); !ok || v == "" {
+			errs = append(errs, fmt.Errorf("%sVariable must be a non-empty string", path))
+		}
+	}
+
+	return errs
+}
@@ -49,3 +49,188 @@ func TestValidStateMachineName(t *testing.T) {
 		}
 	}
 }
+
+func TestValidStateMachineDefinition(t *testing.T) {
+	testCases := []struct {
+		Name            string
+		Definition      string
+		ExpectedError   string
+		ExpectedWarning string
+	}{
+		{
+			Name: "task with retry",
+			Definition: `{
+  "StartAt": "Hello",
+  "States": {
+    "Hello": {
+      "Type": "Task",
+      "Resource": "arn:aws:lambda:us-east-1:123456789012:function:hello",
+      "Retry": [{"ErrorEquals": ["States.ALL"], "MaxAttempts": 5}],
+      "Catch": [{"ErrorEquals": ["States.ALL"], "Next": "Failed"}],
+      "End": true
+    },
+    "Failed": {"Type": "Fail"}
+  }
+}`,
+		},
+		{
+			Name: "choice, parallel and map",
+			Definition: `{
+  "StartAt": "Choose",
+  "States": {
+    "Choose": {
+      "Type": "Choice",
+      "Choices": [
+        {"Variable": "$.n", "NumericGreaterThan": 1, "Next": "Fan"},
+        {"And": [{"Variable": "$.s", "IsPresent": true}, {"Not": {"Variable": "$.s", "StringEquals": "x"}}], "Next": "Each"}
+      ],
+      "Default": "Done"
+    },
+    "Fan": {
+      "Type": "Parallel",
+      "Branches": [{"StartAt": "A", "States": {"A": {"Type": "Pass", "End": true}}}],
+      "Next": "Done"
+    },
+    "Each": {
+      "Type": "Map",
+      "Iterator": {"StartAt": "B", "States": {"B": {"Type": "Succeed"}}},
+      "Next": "Done"
+    },
+    "Done": {"Type": "Succeed"}
+  }
+}`,
+		},
+		{
+			Name:          "invalid JSON",
+			Definition:    `{"StartAt": `,
+			ExpectedError: "invalid JSON object",
+		},
+		{
+			Name:          "missing StartAt state",
+			Definition:    `{"StartAt": "Missing", "States": {"A": {"Type": "Pass", "End": true}}}`,
+			ExpectedError: `StartAt "Missing" does not match any state`,
+		},
+		{
+			Name:          "unresolved Next",
+			Definition:    `{"StartAt": "A", "States": {"A": {"Type": "Pass", "Next": "B"}, "C": {"Type": "Succeed"}}}`,
+			ExpectedError: `States.A: Next "B" does not match any state`,
+		},
+		{
+			Name:          "neither Next nor End",
+			Definition:    `{"StartAt": "A", "States": {"A": {"Type": "Wait", "Seconds": 1}, "B": {"Type": "Succeed"}}}`,
+			ExpectedError: "States.A: one of Next or End must be set",
+		},
+		{
+			Name:          "no terminal state",
+			Definition:    `{"StartAt": "A", "States": {"A": {"Type": "Pass", "Next": "B"}, "B": {"Type": "Pass", "Next": "A"}}}`,
+			ExpectedError: "States must include a terminal state",
+		},
+		{
+			Name: "JSONata choice",
+			Definition: `{
+  "QueryLanguage": "JSONata",
+  "StartAt": "A",
+  "States": {
+    "A": {"Type": "Choice", "Choices": [{"Condition": "{% $states.input.n > 1 %}", "Next": "B"}], "Default": "B"},
+    "B": {"Type": "Succeed"}
+  }
+}`,
+		},
+		{
+			Name: "JSONata Choice state in JSONPath definition",
+			Definition: `{
+  "StartAt": "A",
+  "States": {
+    "A": {"Type": "Choice", "QueryLanguage": "JSONata", "Choices": [{"Condition": "{% $states.input.n > 1 %}", "Next": "B"}]},
+    "B": {"Type": "Succeed"}
+  }
+}`,
+		},
+		{
+			Name:          "choice rule without operator",
+			Definition:    `{"StartAt": "A", "States": {"A": {"Type": "Choice", "Choices": [{"Variable": "$.x", "Next": "B"}]}, "B": {"Type": "Succeed"}}}`,
+			ExpectedError: "States.A: Choices[0]: must have exactly one comparison operator",
+		},
+		{
+			Name:          "choice rule with two operators",
+			Definition:    `{"StartAt": "A", "States": {"A": {"Type": "Choice", "Choices": [{"Variable": "$.x", "IsNull": true, "IsPresent": true, "Next": "B"}]}, "B": {"Type": "Succeed"}}}`,
+			ExpectedError: "States.A: Choices[0]: must have exactly one comparison operator or And, Or or Not, got [IsNull IsPresent]",
+		},
+		{
+			Name:          "JSONata condition in JSONPath definition",
+			Definition:    `{"StartAt": "A", "States": {"A": {"Type": "Choice", "Choices": [{"Condition": "{% true %}", "Next": "B"}]}, "B": {"Type": "Succeed"}}}`,
+			ExpectedError: "States.A: Choices[0]: must have exactly one comparison operator",
+		},
+		{
+			Name:          "choice rule without Variable",
+			Definition:    `{"StartAt": "A", "States": {"A": {"Type": "Choice", "Choices": [{"Or": [{"StringEquals": "x"}], "Next": "B"}]}, "B": {"Type": "Succeed"}}}`,
+			ExpectedError: "States.A: Choices[0]: Or[0]: Variable must be a non-empty string",
+		},
+		{
+			Name:          "choice rule without Next",
+			Definition:    `{"StartAt": "A", "States": {"A": {"Type": "Choice", "Choices": [{"Variable": "$.x", "IsNull": true}], "Default": "B"}, "B": {"Type": "Succeed"}}}`,
+			ExpectedError: "States.A: Choices[0].Next must be a non-empty string",
+		},
+		{
+			Name:          "JSONata choice rule without Next",
+			Definition:    `{"QueryLanguage": "JSONata", "StartAt": "A", "States": {"A": {"Type": "Choice", "Choices": [{"Condition": "{% true %}"}], "Default": "B"}, "B": {"Type": "Succeed"}}}`,
+			ExpectedError: "States.A: Choices[0].Next must be a non-empty string",
+		},
+		{
+			Name:          "choice rule in Parallel branch",
+			Definition:    `{"StartAt": "P", "States": {"P": {"Type": "Parallel", "Branches": [{"StartAt": "A", "States": {"A": {"Type": "Choice", "Choices": [{"Not": {"IsNull": true}, "Next": "B"}]}, "B": {"Type": "Succeed"}}}], "End": true}}}`,
+			ExpectedError: "States.P: Branches[0].States.A: Choices[0]: Not: Variable must be a non-empty string",
+		},
+		{
+			Name:          "unresolved Choice Next",
+			Definition:    `{"StartAt": "A", "States": {"A": {"Type": "Choice", "Choices": [{"Variable": "$.x", "IsNull": true, "Next": "C"}]}, "B": {"Type": "Succeed"}}}`,
+			ExpectedError: `States.A: Choices[0].Next "C" does not match any state`,
+		},
+		{
+			Name:          "unresolved Default",
+			Definition:    `{"StartAt": "A", "States": {"A": {"Type": "Choice", "Choices": [{"Variable": "$.x", "IsNull": true, "Next": "B"}], "Default": "C"}, "B": {"Type": "Succeed"}}}`,
+			ExpectedError: `States.A: Default "C" does not match any state`,
+		},
+		{
+			Name:          "unresolved Next in Parallel branch",
+			Definition:    `{"StartAt": "P", "States": {"P": {"Type": "Parallel", "Branches": [{"StartAt": "A", "States": {"A": {"Type": "Pass", "Next": "Outer"}}}], "Next": "Outer"}, "Outer": {"Type": "Succeed"}}}`,
+			ExpectedError: `States.P: Branches[0].States.A: Next "Outer" does not match any state`,
+		},
+		{
+			Name:            "unknown Type",
+			Definition:      `{"StartAt": "A", "States": {"A": {"Type": "Sleep", "End": true}}}`,
+			ExpectedWarning: `States.A: unknown Type "Sleep" is not validated`,
+		},
+		{
+			Name:          "unknown Type with unresolved Next",
+			Definition:    `{"StartAt": "A", "States": {"A": {"Type": "Sleep", "Next": "C"}, "B": {"Type": "Succeed"}}}`,
+			ExpectedError: `States.A: Next "C" does not match any state`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			warnings, errors := validStateMachineDefinition(testCase.Definition, "definition")
+
+			if testCase.ExpectedWarning != "" && (len(warnings) != 1 || !strings.Contains(warnings[0], testCase.ExpectedWarning)) {
+				t.Errorf("expected warning containing %q, got: %v", testCase.ExpectedWarning, warnings)
+			}
+
+			if testCase.ExpectedError == "" {
+				if len(errors) != 0 {
+					t.Fatalf("expected no errors, got: %v", errors)
+				}
+
+				return
+			}
+
+			for _, err := range errors {
+				if strings.Contains(err.Error(), testCase.ExpectedError) {
+					return
+				}
+			}
+
+			t.Fatalf("expected error containing %q, got: %v", testCase.ExpectedError, errors)
+		})
+	}
+}
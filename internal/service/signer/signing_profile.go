@@ -130,7 +130,7 @@ func resourceSigningProfileCreate(d *schema.ResourceData, meta interface{}) erro
 
 	log.Printf("[DEBUG] Creating Signer signing profile")
 
-	profileName := create.Name(d.Get("name").(string), d.Get("name_prefix").(string))
+	profileName := create.Name(meta.(*conns.AWSClient).NamingPolicy, d.Get("name").(string), d.Get("name_prefix").(string))
 	profileName = strings.Replace(profileName, "-", "_", -1)
 
 	signingProfileInput := &signer.PutSigningProfileInput{
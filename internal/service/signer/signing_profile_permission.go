@@ -100,7 +100,7 @@ func resourceSigningProfilePermissionCreate(d *schema.ResourceData, meta interfa
 		revisionId = aws.StringValue(getProfilePermissionsOutput.RevisionId)
 	}
 
-	statementId := create.Name(d.Get("statement_id").(string), d.Get("statement_id_prefix").(string))
+	statementId := create.Name(meta.(*conns.AWSClient).NamingPolicy, d.Get("statement_id").(string), d.Get("statement_id_prefix").(string))
 
 	addProfilePermissionInput := &signer.AddProfilePermissionInput{
 		Action:      aws.String(d.Get("action").(string)),
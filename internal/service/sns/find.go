@@ -1,6 +1,8 @@
 package sns
 
 import (
+	"context"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/sns"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
@@ -24,3 +26,30 @@ func FindSubscriptionByARN(conn *sns.SNS, id string) (*sns.GetSubscriptionAttrib
 
 	return output, nil
 }
+
+// FindTopicARNs returns the ARNs of all topics.
+func FindTopicARNs(ctx context.Context, conn *sns.SNS) ([]string, error) {
+	var arns []string
+
+	err := conn.ListTopicsPagesWithContext(ctx, &sns.ListTopicsInput{}, func(page *sns.ListTopicsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, topic := range page.Topics {
+			if topic == nil {
+				continue
+			}
+
+			arns = append(arns, aws.StringValue(topic.TopicArn))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return arns, nil
+}
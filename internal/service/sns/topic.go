@@ -189,9 +189,9 @@ func resourceTopicCreate(d *schema.ResourceData, meta interface{}) error {
 	fifoTopic := d.Get("fifo_topic").(bool)
 
 	if fifoTopic {
-		name = create.NameWithSuffix(d.Get("name").(string), d.Get("name_prefix").(string), FIFOTopicNameSuffix)
+		name = create.NameWithSuffix(meta.(*conns.AWSClient).NamingPolicy, d.Get("name").(string), d.Get("name_prefix").(string), FIFOTopicNameSuffix)
 	} else {
-		name = create.Name(d.Get("name").(string), d.Get("name_prefix").(string))
+		name = create.Name(meta.(*conns.AWSClient).NamingPolicy, d.Get("name").(string), d.Get("name_prefix").(string))
 	}
 
 	attributes := make(map[string]*string)
@@ -656,9 +656,9 @@ func resourceTopicCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, me
 		var name string
 
 		if fifoTopic {
-			name = create.NameWithSuffix(diff.Get("name").(string), diff.Get("name_prefix").(string), FIFOTopicNameSuffix)
+			name = create.NameWithSuffix(meta.(*conns.AWSClient).NamingPolicy, diff.Get("name").(string), diff.Get("name_prefix").(string), FIFOTopicNameSuffix)
 		} else {
-			name = create.Name(diff.Get("name").(string), diff.Get("name_prefix").(string))
+			name = create.Name(meta.(*conns.AWSClient).NamingPolicy, diff.Get("name").(string), diff.Get("name_prefix").(string))
 		}
 
 		var re *regexp.Regexp
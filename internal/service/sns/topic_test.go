@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/nij4t/terraform-provider-aws/internal/acctest"
+	"github.com/nij4t/terraform-provider-aws/internal/acctest/fakeaws"
 	"github.com/nij4t/terraform-provider-aws/internal/conns"
 	"github.com/nij4t/terraform-provider-aws/internal/create"
 	tfsns "github.com/nij4t/terraform-provider-aws/internal/service/sns"
@@ -948,3 +949,36 @@ resource "aws_sns_topic" "test" {
 }
 `, r, tag1Key, tag1Value, tag2Key, tag2Value)
 }
+
+func TestSNSTopic_fakeAWS(t *testing.T) {
+	p, _ := acctest.FakeAWSProvider(t)
+	r := acctest.NewFakeAWSResource(t, p, "aws_sns_topic")
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	state := r.Apply(map[string]interface{}{
+		"name": rName,
+	})
+
+	if got, want := state.ID, fmt.Sprintf("arn:%s:sns:%s:%s:%s", acctest.Partition(), acctest.Region(), fakeaws.AccountID, rName); got != want {
+		t.Errorf("ID = %q, want %q", got, want)
+	}
+
+	state = r.Apply(map[string]interface{}{
+		"name":         rName,
+		"display_name": "Test",
+		"tags": map[string]interface{}{
+			"key1": "value1",
+		},
+	})
+
+	if got, want := state.Attributes["display_name"], "Test"; got != want {
+		t.Errorf("display_name = %q, want %q", got, want)
+	}
+
+	if got, want := state.Attributes["tags.key1"], "value1"; got != want {
+		t.Errorf("tags.key1 = %q, want %q", got, want)
+	}
+
+	r.ImportStateVerify()
+	r.Destroy()
+}
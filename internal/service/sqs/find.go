@@ -1,6 +1,8 @@
 package sqs
 
 import (
+	"context"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/sqs"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
@@ -73,3 +75,29 @@ func FindQueuePolicyByURL(conn *sqs.SQS, url string) (string, error) {
 
 	return aws.StringValue(v), nil
 }
+
+// FindQueueURLs returns the URLs of all queues.
+func FindQueueURLs(ctx context.Context, conn *sqs.SQS) ([]string, error) {
+	var urls []string
+
+	// Results are only paginated if MaxResults is set.
+	input := &sqs.ListQueuesInput{
+		MaxResults: aws.Int64(1000),
+	}
+
+	err := conn.ListQueuesPagesWithContext(ctx, input, func(page *sqs.ListQueuesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		urls = append(urls, aws.StringValueSlice(page.QueueUrls)...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return urls, nil
+}
@@ -193,9 +193,9 @@ func resourceQueueCreate(d *schema.ResourceData, meta interface{}) error {
 	fifoQueue := d.Get("fifo_queue").(bool)
 
 	if fifoQueue {
-		name = create.NameWithSuffix(d.Get("name").(string), d.Get("name_prefix").(string), FIFOQueueNameSuffix)
+		name = create.NameWithSuffix(meta.(*conns.AWSClient).NamingPolicy, d.Get("name").(string), d.Get("name_prefix").(string), FIFOQueueNameSuffix)
 	} else {
-		name = create.Name(d.Get("name").(string), d.Get("name_prefix").(string))
+		name = create.Name(meta.(*conns.AWSClient).NamingPolicy, d.Get("name").(string), d.Get("name_prefix").(string))
 	}
 
 	input := &sqs.CreateQueueInput{
@@ -400,9 +400,9 @@ func resourceQueueCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, me
 		var name string
 
 		if fifoQueue {
-			name = create.NameWithSuffix(diff.Get("name").(string), diff.Get("name_prefix").(string), FIFOQueueNameSuffix)
+			name = create.NameWithSuffix(meta.(*conns.AWSClient).NamingPolicy, diff.Get("name").(string), diff.Get("name_prefix").(string), FIFOQueueNameSuffix)
 		} else {
-			name = create.Name(diff.Get("name").(string), diff.Get("name_prefix").(string))
+			name = create.Name(meta.(*conns.AWSClient).NamingPolicy, diff.Get("name").(string), diff.Get("name_prefix").(string))
 		}
 
 		var re *regexp.Regexp
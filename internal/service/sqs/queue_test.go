@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/nij4t/terraform-provider-aws/internal/acctest"
+	"github.com/nij4t/terraform-provider-aws/internal/acctest/fakeaws"
 	"github.com/nij4t/terraform-provider-aws/internal/conns"
 	"github.com/nij4t/terraform-provider-aws/internal/create"
 	tfsqs "github.com/nij4t/terraform-provider-aws/internal/service/sqs"
@@ -929,3 +930,40 @@ resource "aws_sqs_queue" "test" {
 }
 `, rName)
 }
+
+func TestSQSQueue_fakeAWS(t *testing.T) {
+	p, _ := acctest.FakeAWSProvider(t)
+	r := acctest.NewFakeAWSResource(t, p, "aws_sqs_queue")
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	state := r.Apply(map[string]interface{}{
+		"name":          rName,
+		"delay_seconds": 10,
+		"tags": map[string]interface{}{
+			"key1": "value1",
+		},
+	})
+
+	if got, want := state.Attributes["arn"], fmt.Sprintf("arn:%s:sqs:%s:%s:%s", acctest.Partition(), acctest.Region(), fakeaws.AccountID, rName); got != want {
+		t.Errorf("arn = %q, want %q", got, want)
+	}
+
+	state = r.Apply(map[string]interface{}{
+		"name":          rName,
+		"delay_seconds": 20,
+		"tags": map[string]interface{}{
+			"key2": "value2",
+		},
+	})
+
+	if got, want := state.Attributes["delay_seconds"], "20"; got != want {
+		t.Errorf("delay_seconds = %q, want %q", got, want)
+	}
+
+	if got, want := state.Attributes["tags.key2"], "value2"; got != want {
+		t.Errorf("tags.key2 = %q, want %q", got, want)
+	}
+
+	r.ImportStateVerify()
+	r.Destroy()
+}
@@ -72,6 +72,52 @@ func TestSuppressEquivalentTypeStringBoolean(t *testing.T) {
 	}
 }
 
+func TestSuppressEquivalentRFC3339Time(t *testing.T) {
+	testCases := []struct {
+		old        string
+		new        string
+		equivalent bool
+	}{
+		{
+			old:        "2024-01-01T08:00:00Z",
+			new:        "2024-01-01T10:00:00+02:00",
+			equivalent: true,
+		},
+		{
+			old:        "2024-01-01T08:00:00Z",
+			new:        "2024-01-01T08:00:00Z",
+			equivalent: true,
+		},
+		{
+			old:        "2024-01-01T08:00:00Z",
+			new:        "2024-01-01T08:00:00+02:00",
+			equivalent: false,
+		},
+		{
+			old:        "",
+			new:        "2024-01-01T08:00:00Z",
+			equivalent: false,
+		},
+		{
+			old:        "2024-01-01T08:00:00Z",
+			new:        "",
+			equivalent: false,
+		},
+	}
+
+	for i, tc := range testCases {
+		value := SuppressEquivalentRFC3339Time("test_property", tc.old, tc.new, nil)
+
+		if tc.equivalent && !value {
+			t.Fatalf("expected test case %d to be equivalent", i)
+		}
+
+		if !tc.equivalent && value {
+			t.Fatalf("expected test case %d to not be equivalent", i)
+		}
+	}
+}
+
 func TestSuppressEquivalentJSONOrYAMLDiffs(t *testing.T) {
 	testCases := []struct {
 		description string
@@ -5,7 +5,7 @@ import (
 	"flag"
 	"log"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
 	"github.com/nij4t/terraform-provider-aws/internal/provider"
 )
 
@@ -15,17 +15,21 @@ func main() {
 	flag.BoolVar(&debugMode, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
-	opts := &plugin.ServeOpts{ProviderFunc: provider.Provider}
+	serverFactory, _, err := provider.ProtoV5ProviderServerFactory(context.Background())
 
-	if debugMode {
-		err := plugin.Debug(context.Background(), "registry.terraform.io/hashicorp/aws", opts)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
 
-		if err != nil {
-			log.Fatal(err.Error())
-		}
+	var serveOpts []tf5server.ServeOpt
 
-		return
+	if debugMode {
+		serveOpts = append(serveOpts, tf5server.WithManagedDebug())
 	}
 
-	plugin.Serve(opts)
+	err = tf5server.Serve("registry.terraform.io/hashicorp/aws", serverFactory, serveOpts...)
+
+	if err != nil {
+		log.Fatal(err.Error())
+	}
 }